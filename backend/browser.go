@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Page size of the lobby browser when none (or too large a one) is asked for
+const DEFAULT_LOBBY_PAGE_SIZE = 20
+const MAX_LOBBY_PAGE_SIZE = 100
+
+// LobbySummary is what the lobby browser shows about a public lobby
+type LobbySummary struct {
+	Id         string    `json:"id"`
+	Name       string    `json:"name"`
+	Players    int       `json:"players"`
+	MaxPlayers int32     `json:"maxPlayers"`
+	State      GameState `json:"state"`
+	// Length of the game in seconds
+	Duration    int64  `json:"duration"`
+	ScoringMode string `json:"scoringMode"`
+	JudgeMode   string `json:"judgeMode"`
+	LateJoin    string `json:"lateJoin"`
+	// Seconds left on the game clock, if a game is being played
+	TimeRemaining *int64 `json:"timeRemaining,omitempty"`
+
+	createdAt time.Time
+}
+
+func (lobby *Lobby) summary() LobbySummary {
+	players := make(map[string]bool)
+	for client := range lobby.clients {
+		if !client.spectator {
+			players[client.name] = true
+		}
+	}
+
+	summary := LobbySummary{
+		Id:          lobby.id,
+		Name:        lobby.name,
+		Players:     len(players),
+		MaxPlayers:  lobby.settings.GetMaxPlayers(),
+		State:       lobby.gameState,
+		Duration:    lobby.settings.GetDuration().GetSeconds(),
+		ScoringMode: lobby.settings.GetScoringMode().String(),
+		JudgeMode:   lobby.settings.GetJudgeMode().String(),
+		LateJoin:    lobby.settings.GetLateJoin().String(),
+		createdAt:   lobby.createdAt,
+	}
+	if (lobby.inPlay() || lobby.gameState == Paused) && lobby.hasTimeLimit() {
+		remaining := int64(lobby.remaining().Seconds())
+		summary.TimeRemaining = &remaining
+	}
+	return summary
+}
+
+// listLobbiesHandler is the lobby browser: it lists public lobbies, newest first, optionally filtered by
+// state (?state=waiting) and name (?search=...), a page at a time (?offset=0&limit=20)
+func (m *Manager) listLobbiesHandler(w http.ResponseWriter, r *http.Request) {
+	enableCors(&w)
+	query := r.URL.Query()
+
+	state := GameState(query.Get("state"))
+	search := strings.ToLower(query.Get("search"))
+	offset, err := strconv.Atoi(query.Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 || limit > MAX_LOBBY_PAGE_SIZE {
+		limit = DEFAULT_LOBBY_PAGE_SIZE
+	}
+
+	m.RLock()
+	lobbies := make([]LobbySummary, 0, len(m.lobbies))
+	for _, lobby := range m.lobbies {
+		lobby.RLock()
+		if lobby.settings.GetVisibility() == Visibility_PUBLIC &&
+			(state == "" || lobby.gameState == state) &&
+			strings.Contains(strings.ToLower(lobby.name), search) {
+			lobbies = append(lobbies, lobby.summary())
+		}
+		lobby.RUnlock()
+	}
+	m.RUnlock()
+
+	sort.Slice(lobbies, func(i, j int) bool {
+		return lobbies[i].createdAt.After(lobbies[j].createdAt)
+	})
+
+	type response struct {
+		Total   int            `json:"total"`
+		Lobbies []LobbySummary `json:"lobbies"`
+	}
+	resp := response{Total: len(lobbies), Lobbies: []LobbySummary{}}
+	if offset < len(lobbies) {
+		end := offset + limit
+		if end > len(lobbies) {
+			end = len(lobbies)
+		}
+		resp.Lobbies = lobbies[offset:end]
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		log.Println(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
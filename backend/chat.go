@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Longest chat message (in characters) a player can send
+const MAX_CHAT_LENGTH = 280
+
+// How many chat messages a player can send at once, and how often they can send another after that
+const CHAT_BURST = 5
+const CHAT_INTERVAL = 2 * time.Second
+
+// How many of the most recent chat messages are sent to someone when they connect
+const CHAT_HISTORY_SIZE = 20
+
+// recordChat adds the message to the lobby's recent history, dropping the oldest if it's full
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) recordChat(message *ServerSent_Chat_) {
+	lobby.chatHistory = append(lobby.chatHistory, message)
+	if len(lobby.chatHistory) > CHAT_HISTORY_SIZE {
+		lobby.chatHistory = lobby.chatHistory[len(lobby.chatHistory)-CHAT_HISTORY_SIZE:]
+	}
+}
+
+// sendChatHistory catches a client up on the lobby's recent chat
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) sendChatHistory(client *Client) {
+	for _, message := range lobby.chatHistory {
+		client.send(message)
+	}
+}
+
+// ChatHandler is sent by a player to chat to everyone in the lobby
+func ChatHandler(event *ClientSent_Chat, c *Client) error {
+	lobby := c.lobby
+	text := strings.TrimSpace(event.GetText())
+
+	var err error
+	if lobby.settings.GetChatDisabledInPlay() && (lobby.inPlay() || lobby.gameState == Paused) {
+		err = fmt.Errorf("chat is disabled while the game is being played")
+	} else if text == "" {
+		return fmt.Errorf("empty chat message from %s", c.name)
+	} else if utf8.RuneCountInString(text) > MAX_CHAT_LENGTH {
+		err = fmt.Errorf("chat messages can be at most %d characters long", MAX_CHAT_LENGTH)
+	} else if !lobby.chatLimiter(c.name).allow(time.Now()) {
+		err = fmt.Errorf("you're sending messages too quickly")
+	}
+	if err != nil {
+		c.sendError(err)
+		return err
+	}
+
+	name := c.name
+	message := &ServerSent_Chat_{Chat: &ServerSent_Chat{Name: &name, Text: &text, SentAt: timestamppb.Now()}}
+	lobby.recordChat(message)
+	lobby.broadcast(message)
+
+	return nil
+}
+
+// SetChatDisabledHandler is sent by the owner to turn chat off (or back on) while the game's being played,
+// which, unlike the rest of the settings, they can do during the game
+func SetChatDisabledHandler(event *ClientSent_SetChatDisabled, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can turn chat on or off")
+	}
+
+	disabled := event.GetDisabled()
+	lobby.settings = withChatDisabled(lobby.settings, disabled)
+	// It lasts for the rest of a match, rather than just the round being played
+	if lobby.match.active {
+		lobby.match.settings = withChatDisabled(lobby.match.settings, disabled)
+	}
+	lobby.broadcast(lobby.settingsMessage())
+
+	return nil
+}
+
+// withChatDisabled is a copy of the settings with chat turned off (or on) during play; settings are
+// never changed in place, as they can be shared (by a match and its rounds)
+func withChatDisabled(settings *LobbySettings, disabled bool) *LobbySettings {
+	settings = proto.Clone(settings).(*LobbySettings)
+	settings.ChatDisabledInPlay = &disabled
+	return settings
+}
+
+// chatLimiter gives the player's chat rate limiter, which is shared between all their connections
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) chatLimiter(name string) *rateLimiter {
+	limiter, ok := lobby.chatLimiters[name]
+	if !ok {
+		limiter = newRateLimiter(CHAT_BURST, CHAT_INTERVAL)
+		lobby.chatLimiters[name] = limiter
+	}
+	return limiter
+}
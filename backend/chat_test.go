@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSetChatDisabledHandler(t *testing.T) {
+	lobby := playingLobby(t, "chat-disabled", map[string]User{"alice": {}, "bob": {}})
+	owner := "alice"
+	lobby.owner = &owner
+	clients := map[string]*Client{}
+	for client := range lobby.clients {
+		clients[client.name] = client
+	}
+	chat := &ClientSent_Chat{Text: proto.String("hello")}
+
+	if err := SetChatDisabledHandler(&ClientSent_SetChatDisabled{Disabled: proto.Bool(true)}, clients["bob"]); err == nil {
+		t.Error("expected only the owner to be able to turn chat off")
+	}
+
+	// The game's already being played, which is when the rest of the settings can't be changed
+	if err := SetChatDisabledHandler(&ClientSent_SetChatDisabled{Disabled: proto.Bool(true)}, clients["alice"]); err != nil {
+		t.Fatalf("expected the owner to be able to turn chat off during the game, got %v", err)
+	}
+	if err := ChatHandler(chat, clients["bob"]); err == nil {
+		t.Error("expected chat to be refused once it's been turned off")
+	}
+
+	if err := SetChatDisabledHandler(&ClientSent_SetChatDisabled{Disabled: proto.Bool(false)}, clients["alice"]); err != nil {
+		t.Fatalf("expected the owner to be able to turn chat back on, got %v", err)
+	}
+	if err := ChatHandler(chat, clients["bob"]); err != nil {
+		t.Errorf("expected chat to be allowed once it's been turned back on, got %v", err)
+	}
+}
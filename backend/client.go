@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -12,56 +13,118 @@ import (
 const OWNER_MAX_MESSAGE_SIZE = 131072
 const PLAYER_MAX_MESSAGE_SIZE = 512
 
+// Close codes (in the range reserved for applications) sent when the owner removes a player, or
+// the lobby's expired
+const CLOSE_KICKED = 4000
+const CLOSE_BANNED = 4001
+const CLOSE_EXPIRED = 4002
+
 // ClientList is a map used to help manage a map of clients
 type ClientList map[*Client]bool
 
 // Client is a websocket client, basically a frontend visitor
 type Client struct {
 	// the websocket connection
-	connection *websocket.Conn
-	name       string
-	lobby      *Lobby
+	connection  *websocket.Conn
+	name        string
+	lobby       *Lobby
+	connectedAt time.Time
+	ip          string
+	// spectators watch without playing, seeing the problems of the player they're following
+	spectator bool
+	following string
+	// session lets the client resume where it left off if it reconnects
+	session *session
 
 	// manager used to manage the client
 	manager *Manager
-	// egress is used to avoid concurrent writes on the WebSocket
+	// egress is used to avoid concurrent writes on the WebSocket; it's buffered, so that the lobby (whose
+	// lock is held while sending) never waits on a slow client
 	egress chan []byte
+	// done is closed once the client has been removed, so nothing blocks on egress afterwards
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
 var (
 	// pongWait is how long we will await a pong response from client
 	pongWait     = 10 * time.Second
 	pingInterval = (pongWait * 9) / 10
+	// writeWait is how long writing a message to the client can take
+	writeWait = 10 * time.Second
 )
 
+// How many messages can be waiting to be written to a client before it's dropped for being too slow; this
+// has room for a whole session's replay
+const EGRESS_BUFFER_SIZE = 2 * SESSION_OUTBOX_SIZE
+
 // NewClient is used to initialize a new Client with all required values initialized
-func NewClient(conn *websocket.Conn, manager *Manager, lobby *Lobby, otp string) *Client {
+func NewClient(conn *websocket.Conn, manager *Manager, lobby *Lobby, session *session, ip string) *Client {
 	return &Client{
-		connection: conn,
-		manager:    manager,
-		lobby:      lobby,
-		name:       lobby.otpMapping[otp],
-		egress:     make(chan []byte),
+		connection:  conn,
+		manager:     manager,
+		lobby:       lobby,
+		name:        session.name,
+		connectedAt: time.Now(),
+		ip:          ip,
+		spectator:   session.spectator,
+		session:     session,
+		egress:      make(chan []byte, EGRESS_BUFFER_SIZE),
+		done:        make(chan struct{}),
+	}
+}
+
+// send queues a message to be written to the client, dropping it if the client has been closed (though
+// the client's session keeps it, in case the client reconnects)
+func (c *Client) send(message isServerSent_Message) {
+	c.deliver(c.session.record(message))
+}
+
+// sendTransient queues a message that's stale as soon as the next one like it is sent, so it's neither
+// numbered nor kept by the session, and can't crowd the messages that need replaying out of its outbox
+func (c *Client) sendTransient(message isServerSent_Message) {
+	data, err := proto.Marshal(&ServerSent{Message: message})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	c.deliver(data)
+}
+
+// deliver queues the data to be written, disconnecting the client if it's fallen too far behind
+func (c *Client) deliver(data []byte) {
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+
+	select {
+	case c.egress <- data:
+	default:
+		log.Println("Disconnecting " + c.name + " in lobby " + c.lobby.name + " for falling behind")
+		c.close()
+		// Unblocks the writer if it's stuck, and the reader, which removes the client
+		c.connection.Close()
 	}
 }
 
+// close marks the client as closed; it's safe to call multiple times
+func (c *Client) close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
 // readMessages will start the client to read messages and handle them
 // appropriatly.
 // This is suppose to be ran as a goroutine
 func (c *Client) readMessages() {
 	defer func() {
-		// Graceful close the connection once this function is done
+		// Graceful close the connection once this function is done; the client's closed first, so
+		// nothing's left waiting to send to it
+		c.close()
 		c.lobby.removeClient(c)
 	}()
 
-	var maxMessageSize int64 = PLAYER_MAX_MESSAGE_SIZE
-	if *c.lobby.owner == c.name {
-		maxMessageSize = OWNER_MAX_MESSAGE_SIZE
-	}
-
-	// Set max size of messages in bytes
-	c.connection.SetReadLimit(maxMessageSize)
-
 	// Configure wait time for pong response, use `current time + pongWait`
 	// This has to be done here to set the first initial timer
 	if err := c.connection.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
@@ -74,6 +137,9 @@ func (c *Client) readMessages() {
 
 	// Loop Forever
 	for {
+		// Set max size of messages in bytes; this is checked every message as ownership can change
+		c.connection.SetReadLimit(c.maxMessageSize())
+
 		// ReadMessage is used to read the next message in queue in the connection
 		_, payload, err := c.connection.ReadMessage()
 		if err != nil {
@@ -96,6 +162,25 @@ func (c *Client) readMessages() {
 	}
 }
 
+// closeWithReason tells the client why it's being disconnected, before the lobby drops it
+func (c *Client) closeWithReason(code int, reason string) {
+	message := websocket.FormatCloseMessage(code, reason)
+	if err := c.connection.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second)); err != nil {
+		log.Println(err)
+	}
+}
+
+// maxMessageSize is the largest message the client may send, which depends on whether they own the lobby
+func (c *Client) maxMessageSize() int64 {
+	c.lobby.RLock()
+	defer c.lobby.RUnlock()
+
+	if c.lobby.isOwner(c.name) {
+		return OWNER_MAX_MESSAGE_SIZE
+	}
+	return PLAYER_MAX_MESSAGE_SIZE
+}
+
 // pongHandler is used to handle PongMessages for the Client
 func (c *Client) pongHandler(pongMsg string) error {
 	// Current time + Pong Wait time
@@ -108,7 +193,8 @@ func (c *Client) writeMessages() {
 	ticker := time.NewTicker(pingInterval)
 	defer func() {
 		ticker.Stop()
-		// Graceful close if this triggers a closing
+		// Stop anyone from queueing messages we'll never write, then clean up
+		c.close()
 		c.lobby.removeClient(c)
 	}()
 
@@ -132,15 +218,20 @@ func (c *Client) writeMessages() {
 			// 	return // TODO: do we need to close the connection?
 			// }
 			// Write a regular text message to the connection
+			c.connection.SetWriteDeadline(time.Now().Add(writeWait))
 			if err := c.connection.WriteMessage(websocket.BinaryMessage, message); err != nil {
 				log.Println(err)
+				return
 			}
 		case <-ticker.C:
 			// Send the Ping
+			c.connection.SetWriteDeadline(time.Now().Add(writeWait))
 			if err := c.connection.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
 				log.Println("writemsg: ", err)
 				return // return to break this goroutine triggering cleanup
 			}
+		case <-c.done:
+			return
 		}
 
 	}
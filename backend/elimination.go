@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+func (l *Lobby) eliminationEnabled() bool {
+	return l.settings.GetElimination().GetEnabled()
+}
+
+func (l *Lobby) eliminationInterval() time.Duration {
+	return time.Duration(l.settings.GetElimination().GetInterval().GetSeconds()) * time.Second
+}
+
+// eliminatesEachRound is true if players are eliminated at the end of every race round, rather than on a timer
+func (l *Lobby) eliminatesEachRound() bool {
+	return l.eliminationEnabled() && l.isRace() && l.eliminationInterval() == 0
+}
+
+func (l *Lobby) isEliminated(name string) bool {
+	return l.userMapping[name].eliminated
+}
+
+// remainingPlayers gives the names of the members who haven't been eliminated, lowest score first
+func (l *Lobby) remainingPlayers() []string {
+	remaining := make([]string, 0, len(l.userMapping))
+	for name, user := range l.userMapping {
+		if !user.eliminated {
+			remaining = append(remaining, name)
+		}
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		a, b := l.userMapping[remaining[i]], l.userMapping[remaining[j]]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		return remaining[i] < remaining[j]
+	})
+	return remaining
+}
+
+// armEliminationTimer eliminates the lowest scorers once the next interval is up on the game clock
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) armEliminationTimer() {
+	lobby.stopEliminationTimer()
+	if !lobby.eliminationEnabled() || lobby.eliminationInterval() == 0 {
+		return
+	}
+	if lobby.nextElimination == 0 {
+		lobby.nextElimination = lobby.eliminationInterval()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(lobby.nextElimination-lobby.elapsed(), func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		// The timer may have fired just as the game was paused (and since been replaced)
+		if lobby.eliminationTimer != timer || !lobby.inPlay() {
+			return
+		}
+
+		lobby.eliminateLowest()
+		if lobby.inPlay() {
+			lobby.nextElimination += lobby.eliminationInterval()
+			lobby.armEliminationTimer()
+		}
+	})
+	lobby.eliminationTimer = timer
+}
+
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) stopEliminationTimer() {
+	if lobby.eliminationTimer != nil {
+		lobby.eliminationTimer.Stop()
+		lobby.eliminationTimer = nil
+	}
+}
+
+// eliminateLowest knocks out the lowest scorers, always leaving at least one player in
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) eliminateLowest() {
+	remaining := lobby.remainingPlayers()
+
+	count := int(lobby.settings.GetElimination().GetPerElimination())
+	if count < 1 {
+		count = 1
+	}
+	count = minInt(count, len(remaining)-1)
+	if count <= 0 {
+		return
+	}
+
+	lobby.eliminate(remaining[:count])
+}
+
+// eliminate knocks out the players (worst first), who then finish in last place among those still in;
+// once there's a single player left, they've won and the game's over
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) eliminate(names []string) {
+	left := int32(len(lobby.remainingPlayers()))
+
+	for i, name := range names {
+		user := lobby.userMapping[name]
+		user.eliminated = true
+		user.place = left - int32(i)
+		lobby.userMapping[name] = user
+
+		name, place := name, user.place
+		lobby.broadcast(&ServerSent_Eliminated_{Eliminated: &ServerSent_Eliminated{Name: &name, Place: &place}})
+	}
+
+	if remaining := lobby.remainingPlayers(); len(remaining) == 1 {
+		lobby.winner = remaining[0]
+		lobby.finishGame(remaining[0] + " won!")
+	}
+}
+
+// suddenDeath eliminates a player for answering wrongly, if the lobby's playing with sudden death
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) suddenDeath(name string) {
+	if !lobby.eliminationEnabled() || !lobby.settings.GetElimination().GetSuddenDeath() {
+		return
+	}
+	// The last player standing can't be eliminated
+	if len(lobby.remainingPlayers()) <= 1 {
+		return
+	}
+	lobby.eliminate([]string{name})
+}
+
+// sendEliminations catches a client up on who's been eliminated so far
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) sendEliminations(client *Client) {
+	for name, user := range lobby.userMapping {
+		if user.eliminated {
+			name, place := name, user.place
+			client.send(&ServerSent_Eliminated_{Eliminated: &ServerSent_Eliminated{Name: &name, Place: &place}})
+		}
+	}
+}
+
+func (l *Lobby) validateElimination(settings *LobbySettings) error {
+	elimination := settings.GetElimination()
+	if !elimination.GetEnabled() {
+		return nil
+	}
+
+	if elimination.GetInterval().GetSeconds() < 0 {
+		return fmt.Errorf("elimination interval can't be negative")
+	} else if elimination.GetInterval().GetSeconds() == 0 && settings.GetGameMode() != GameMode_RACE {
+		return fmt.Errorf("players can only be eliminated after every round in race mode")
+	} else if elimination.GetPerElimination() < 0 || elimination.GetPerElimination() > MAX_PLAYERS {
+		return fmt.Errorf("between 0 and %d players can be eliminated at a time", MAX_PLAYERS)
+	}
+	return nil
+}
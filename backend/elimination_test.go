@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLobby_EliminationPlaces(t *testing.T) {
+	tests := []struct {
+		name           string
+		perElimination int32
+		// how many times the lowest scorers are eliminated
+		eliminations int
+	}{
+		{name: "one at a time", perElimination: 1, eliminations: 2},
+		{name: "two at once", perElimination: 2, eliminations: 1},
+		{name: "more than are left", perElimination: 5, eliminations: 1},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			users := map[string]User{"alice": {score: 5}, "bob": {score: 3}, "carol": {score: 1}}
+			lobby := playingLobby(t, fmt.Sprintf("elimination-%d", i), users)
+			lobby.settings.Elimination = &EliminationSettings{
+				Enabled:        proto.Bool(true),
+				Interval:       &timestamppb.Timestamp{Seconds: 60},
+				PerElimination: proto.Int32(test.perElimination),
+			}
+
+			for i := 0; i < test.eliminations; i++ {
+				lobby.eliminateLowest()
+			}
+
+			if lobby.gameState != Finished || lobby.winner != "alice" {
+				t.Fatalf("the last player left should have won, got %q winning and the game %s", lobby.winner, lobby.gameState)
+			}
+			want := []string{"alice", "bob", "carol"}
+			if got := lobby.standings(); !reflect.DeepEqual(got, want) {
+				t.Errorf("expected the standings %v (the first eliminated finishing last), got %v", want, got)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -15,13 +14,13 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const DEBUG = true
-
 // EventHandler is a function signature that is used to affect messages on the socket,
 // and triggered depending on the type
 type EventHandler func(event ClientSent, c *Client) error
 
+// Default length of the countdown before a game starts, and the longest an owner can ask for
 const TIME_TO_START_GAME = 0 * time.Second
+const MAX_TIME_TO_START_GAME = 60 * time.Second
 
 var (
 	problems []*Problem
@@ -74,50 +73,97 @@ func (l *Lobby) getLobbyProblems() []*Problem {
 	}
 }
 
-func protofy(x isServerSent_Message) []byte {
+func protofy(x isServerSent_Message, seq uint64) []byte {
 	log.Println(x)
-	data, err := proto.Marshal(&ServerSent{Message: x})
+	data, err := proto.Marshal(&ServerSent{Seq: &seq, Message: x})
 	if err != nil {
 		log.Println("oh no ", err)
 	}
 	return data
 }
 
+func (c *Client) sendError(err error) {
+	reason := err.Error()
+	c.send(&ServerSent_Error_{Error: &ServerSent_Error{Reason: &reason}})
+}
+
 func endGame(c *Client, message string) error {
 	var outgoingEvent = &ServerSent_End{&ServerSent_EndGame{}}
-	c.egress <- protofy(outgoingEvent)
+	c.send(outgoingEvent)
 	return nil
 }
 
 func endGameLobby(l *Lobby, message string) error {
 	var outgoingEvent = &ServerSent_End{&ServerSent_EndGame{}}
-	for client := range l.clients {
-		client.egress <- protofy(outgoingEvent)
+	if l.winner != "" {
+		winner := l.winner
+		outgoingEvent.End.Winner = &winner
 	}
+	l.broadcast(outgoingEvent)
 	return nil
 }
 
+type SolvedProblem struct {
+	Title string `json:"title"`
+	// Time taken on the game clock, so time spent paused isn't counted
+	Seconds float64 `json:"seconds"`
+}
+
+type Player struct {
+	Name   string          `json:"name"`
+	Score  int32           `json:"score"`
+	Solves []SolvedProblem `json:"solves"`
+	Team   string          `json:"team,omitempty"`
+	// Where the player finished, in an elimination or first-to-N game
+	Place    int32           `json:"place,omitempty"`
+	Handicap *PlayerHandicap `json:"handicap,omitempty"`
+}
+
+type TeamResult struct {
+	Name    string   `json:"name"`
+	Score   int32    `json:"score"`
+	Players []string `json:"players"`
+}
+
+type SavedGameResult struct {
+	Name           string    `json:"name"`
+	Players        []Player  `json:"players"`
+	StartTimestamp time.Time `json:"startTimestamp"`
+	GameDuration   int       `json:"gameDuration"`
+	// The players grouped by team, if the lobby had teams
+	Teams []TeamResult `json:"teams,omitempty"`
+	// The players in the order they finished, winner first, in an elimination or first-to-N game
+	Standings []string `json:"standings,omitempty"`
+	// The match's standings so far, if the game was a round of one
+	Match *MatchResult `json:"match,omitempty"`
+	// Games played in the same lobby before this one (through rematches), oldest first
+	PreviousGames []SavedGameResult `json:"previousGames,omitempty"`
+}
+
 // @dev Requires that the lobby is in the Finished state
 func (l *Lobby) saveEndedGame() {
 	if l.gameState != Finished {
 		return
 	}
 
-	type Player struct {
-		Name  string `json:"name"`
-		Score int32  `json:"score"`
+	var savedGameRes = SavedGameResult{l.name, make([]Player, 0, len(l.userMapping)), *l.startTime, int(l.duration().Seconds()), nil, nil, nil, l.pastGames}
+	for name, user := range l.userMapping {
+		savedGameRes.Players = append(savedGameRes.Players, Player{name, user.score, user.solves, user.team, user.place, l.savedHandicap(name)})
 	}
-	type SavedGameResult struct {
-		Name           string    `json:"name"`
-		Players        []Player  `json:"players"`
-		StartTimestamp time.Time `json:"startTimestamp"`
-		GameDuration   int       `json:"gameDuration"`
+	members, scores := l.teamMembers(), l.teamScores()
+	for _, team := range l.teams {
+		savedGameRes.Teams = append(savedGameRes.Teams, TeamResult{team, scores[team], members[team]})
 	}
-
-	var savedGameRes = SavedGameResult{l.name, make([]Player, 0, len(l.userMapping)), *l.startTime, l.timeLimit}
-	for name, user := range l.userMapping {
-		savedGameRes.Players = append(savedGameRes.Players, Player{name, user.score})
+	if l.isRanked() {
+		savedGameRes.Standings = l.standings()
+	}
+	if l.match.active {
+		savedGameRes.Match = l.matchResult()
 	}
+	// Archive this game (without its own history), as a rematch will reset the scores
+	archived := savedGameRes
+	archived.PreviousGames = nil
+	l.pastGames = append(l.pastGames, archived)
 
 	data, err := json.Marshal(savedGameRes)
 	if err != nil {
@@ -149,78 +195,402 @@ func StartGameHandler(event *ClientSent_RequestStart, c *Client) error {
 	// if err := json.Unmarshal(event.Payload, &reqevent); err != nil {
 	// 	return log.Errorf("bad payload in request: %v", err)
 	// }
-	if *lobby.owner != c.name {
+	if !lobby.isOwner(c.name) {
 		return fmt.Errorf("only the owner can start the game")
-	} else if lobby.inPlay() {
+	}
+
+	if err := lobby.start(event); err != nil {
+		c.sendError(err)
+		return err
+	}
+	return nil
+}
+
+// start is how every game starts, whether the owner asked for it or it was scheduled
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) start(event *ClientSent_RequestStart) error {
+	if lobby.gameState == Expired {
+		return fmt.Errorf("lobby has expired")
+	} else if lobby.inPlay() || lobby.gameState == Paused {
 		return fmt.Errorf("game is already in progress")
+	} else if lobby.gameState == Countdown {
+		return fmt.Errorf("game is already starting")
+	} else if lobby.gameState == Finished {
+		return fmt.Errorf("game has finished, a rematch has to be requested first")
 	}
 
-	lobby.timeLimit = int(event.Duration.Seconds)
+	if !event.GetForce() {
+		if err := lobby.checkReady(); err != nil {
+			return err
+		}
+	}
 
+	usedCustom, customProblems := lobby.useCustom, lobby.CustomProblems
 	if len(event.Problems) > 0 {
 		lobby.useCustom = true
 		lobby.CustomProblems = event.Problems
 	}
-	lobbyProblems := lobby.getLobbyProblems()
 
-	lobby.CustomOrder = make([]int, len(lobbyProblems))
+	// Whatever's set in the request overrides the lobby's settings
+	update := &LobbySettings{Duration: event.Duration, RandomOrder: event.IsRandom, Countdown: event.Countdown}
+	if err := lobby.updateSettings(update); err != nil {
+		lobby.useCustom, lobby.CustomProblems = usedCustom, customProblems
+		return err
+	}
+	lobby.stopSchedule()
+	lobby.beginMatch()
+
+	lobby.CustomOrder = filterProblems(lobby.getLobbyProblems(), lobby.settings.GetProblemFilter())
+	if lobby.settings.GetRandomOrder() {
+		rand.Shuffle(len(lobby.CustomOrder), func(i, j int) {
+			lobby.CustomOrder[i], lobby.CustomOrder[j] = lobby.CustomOrder[j], lobby.CustomOrder[i]
+		})
+	}
 
-	if *event.IsRandom {
-		booleanArray := make([]bool, len(lobbyProblems))
-		for i := 0; i < len(lobbyProblems); i++ {
-			x := rand.Intn(len(booleanArray))
-			for booleanArray[x] {
-				x = rand.Intn(len(booleanArray))
-			}
-			lobby.CustomOrder[i] = x
-			booleanArray[x] = true
-		}
-	} else {
-		for i := 0; i < len(lobbyProblems); i++ {
-			lobby.CustomOrder[i] = i
-		}
+	if lobby.hasTeams() {
+		lobby.assignRemainingPlayers()
+		lobby.broadcast(lobby.teamsMessage())
 	}
 
-	startTime := time.Now().Add(TIME_TO_START_GAME)
+	countdown := lobby.countdown()
+	startTime := time.Now().Add(countdown)
 	lobby.startTime = &startTime
 
-	if !DEBUG {
-		time.Sleep(TIME_TO_START_GAME)
+	lobby.startCountdown()
+	lobby.broadcast(lobby.countdownMessage())
+
+	if countdown == 0 {
+		lobby.beginPlay()
+		return nil
 	}
 
+	// Everyone starts when the timer fires, rather than when their countdown message arrived
+	lobby.startTimer = time.AfterFunc(countdown, func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		if lobby.gameState != Countdown {
+			return
+		}
+		lobby.beginPlay()
+	})
+
+	return nil
+}
+
+func (l *Lobby) countdownMessage() *ServerSent_Countdown_ {
+	return &ServerSent_Countdown_{Countdown: &ServerSent_Countdown{
+		StartTime: timestamppb.New(*l.startTime), ServerTime: timestamppb.Now(),
+	}}
+}
+
+// beginPlay moves a lobby that's counted down into play, sending everyone their first problem
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) beginPlay() {
 	lobby.startGame()
 
 	// Send start game message
-	var outgoingEvent = ServerSent{Message: &ServerSent_Start{
-		&ServerSent_StartGame{StartTime: timestamppb.New(startTime), Duration: &timestamppb.Timestamp{Seconds: int64(lobby.timeLimit)}}},
+	lobby.broadcast(&ServerSent_Start{
+		&ServerSent_StartGame{StartTime: timestamppb.New(*lobby.startTime), Duration: lobby.settings.Duration}},
+	)
+
+	lobby.applyHeadStarts()
+	lobby.armEndTimer()
+	lobby.armEliminationTimer()
+
+	if lobby.isRace() {
+		lobby.startRound(0)
+		return
 	}
-	data, _ := proto.Marshal(&outgoingEvent)
+
+	// Send the first problem (all users get the same problem & their question number starts off at 0)
+	var newProblemBroadcast = lobby.getNewProblem(0)
+
 	for client := range lobby.clients {
-		client.egress <- data
+		if client.spectator {
+			lobby.sendFollowedProblem(client)
+		} else {
+			client.send(&newProblemBroadcast)
+		}
 	}
+	for _, session := range lobby.disconnectedSessions() {
+		if !session.spectator {
+			session.record(&newProblemBroadcast)
+		}
+	}
+}
 
-	// Send the first problem (all users get the same problem & their question number starts off at 0)
+// armEndTimer ends the game once the rest of its time limit has been played; the lobby (and its
+// connections) are kept around afterwards, so that the owner can start a rematch. Players without
+// as much extra time as others have their game ended at their own deadline
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) armEndTimer() {
+	if !lobby.hasTimeLimit() {
+		return
+	}
+
+	deadline := lobby.nextDeadline()
+	var timer *time.Timer
+	timer = time.AfterFunc(deadline-lobby.elapsed(), func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		// The timer may have fired just as the game was paused (and since been replaced)
+		if lobby.endTimer != timer || !lobby.inPlay() {
+			return
+		}
+
+		if deadline < lobby.gameLength() {
+			lobby.timeUp(deadline)
+			lobby.finishIfEveryoneDone()
+			if lobby.inPlay() {
+				lobby.armEndTimer()
+			}
+			return
+		}
+
+		lobby.finishGame("Game over!")
+	})
+	lobby.endTimer = timer
+}
+
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) stopEndTimer() {
+	if lobby.endTimer != nil {
+		lobby.endTimer.Stop()
+		lobby.endTimer = nil
+	}
+}
+
+// finishGame is how every game ends, whether that's the timer running out, the owner ending it
+// early or every player running out of problems
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) finishGame(message string) {
+	lobby.stopEndTimer()
+	lobby.stopRoundTimer()
+	lobby.stopEliminationTimer()
+	lobby.endGame()
+	lobby.settleStandings()
+
+	lobby.recordMatchRound()
+	endGameLobby(lobby, message)
+
+	lobby.saveEndedGame()
+	lobby.endMatchRound()
+}
+
+// hasRunOutOfProblems is true once the user has gone through every problem in the game
+func (lobby *Lobby) hasRunOutOfProblems(name string) bool {
+	return lobby.userMapping[name].questionNumber >= int32(len(lobby.CustomOrder))
+}
+
+// finishIfEveryoneDone ends the game once every player that's still around has run out of problems or
+// time (or in race mode, the round once every player that's still around has solved it); players that have
+// disconnected still count until they're counted as having left, as they might come back
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) finishIfEveryoneDone() {
+	if !lobby.inPlay() {
+		return
+	} else if lobby.isRace() {
+		lobby.endRoundIfEveryoneDone()
+		return
+	}
+	anyPlayers := false
+	for name := range lobby.userMapping {
+		if !lobby.isPresent(name) || lobby.isEliminated(name) {
+			continue
+		}
+		if !lobby.hasRunOutOfProblems(name) && !lobby.isOutOfTime(name) {
+			return
+		}
+		anyPlayers = true
+	}
+	if anyPlayers {
+		lobby.finishGame("Everyone ran out of problems!")
+	}
+}
+
+// EndGameHandler is sent by the owner to end the game before its time limit; in a match, it ends the
+// whole match (so it can also be sent between the match's rounds)
+func EndGameHandler(event *ClientSent_RequestEnd, c *Client) error {
+	lobby := c.lobby
 
-	var newProblemBroadcast = c.getNewProblem()
-	// log.Println(newProblemBroadcast)
+	betweenRounds := lobby.match.active && lobby.gameState == Finished
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can end the game")
+	} else if !lobby.inPlay() && lobby.gameState != Paused && !betweenRounds {
+		return fmt.Errorf("game is not in progress")
+	}
 
+	lobby.callOffMatch()
+	if !betweenRounds {
+		lobby.finishGame("Game ended by the owner!")
+	}
+
+	return nil
+}
+
+func (l *Lobby) pausedMessage() *ServerSent_Paused_ {
+	return &ServerSent_Paused_{Paused: &ServerSent_Paused{Remaining: &timestamppb.Timestamp{Seconds: int64(l.remaining().Seconds())}}}
+}
+
+// PauseHandler is sent by the owner to stop the game clock
+func PauseHandler(event *ClientSent_PauseGame, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can pause the game")
+	} else if !lobby.inPlay() {
+		return fmt.Errorf("game is not in progress")
+	}
+
+	lobby.stopEndTimer()
+	lobby.stopRoundTimer()
+	lobby.stopEliminationTimer()
+	lobby.pauseGame()
+	lobby.broadcast(lobby.pausedMessage())
+
+	return nil
+}
+
+// ResumeHandler is sent by the owner to restart the game clock after a pause
+func ResumeHandler(event *ClientSent_ResumeGame, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can resume the game")
+	} else if lobby.gameState != Paused {
+		return fmt.Errorf("game is not paused")
+	}
+
+	lobby.resumeGame()
+	lobby.armEndTimer()
+	lobby.armEliminationTimer()
+	if lobby.isRace() {
+		lobby.armRoundTimer()
+	}
+	lobby.broadcast(&ServerSent_Resumed_{Resumed: &ServerSent_Resumed{Remaining: &timestamppb.Timestamp{Seconds: int64(lobby.remaining().Seconds())}}})
+
+	return nil
+}
+
+// UpdateSettingsHandler is sent by the owner to change the lobby's settings before the game starts
+func UpdateSettingsHandler(event *ClientSent_UpdateSettings, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can change the settings")
+	} else if lobby.gameState != WaitingForPlayers {
+		return fmt.Errorf("settings can only be changed while waiting for players")
+	}
+
+	if err := lobby.updateSettings(event.GetSettings()); err != nil {
+		c.sendError(err)
+		return err
+	}
+	// The pre-game countdown for a scheduled start might be longer or shorter now
+	if lobby.scheduledStart != nil {
+		lobby.armScheduleTimer()
+	}
+
+	return nil
+}
+
+// TransferOwnershipHandler is sent by the owner to hand the lobby over to another connected player
+func TransferOwnershipHandler(event *ClientSent_TransferOwnership, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can transfer ownership")
+	} else if !lobby.isConnected(event.GetName()) {
+		return fmt.Errorf("%s isn't connected to the lobby", event.GetName())
+	}
+
+	lobby.setOwner(event.GetName())
+
+	return nil
+}
+
+// removePlayer disconnects every client the player has open, telling them why, and lets everyone
+// else know they're gone
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) removePlayer(name string, code int, reason string) {
 	for client := range lobby.clients {
-		client.egress <- protofy(&newProblemBroadcast)
+		if client.name == name && !client.spectator {
+			client.closeWithReason(code, reason)
+			lobby.dropClient(client)
+		}
 	}
+	lobby.endSessions(name)
+	lobby.markLeft(name)
+	lobby.broadcast(&ServerSent_Remove{Remove: &ServerSent_RemoveMember{Name: &name}})
+	// They still counted as able to come back while their clients were being dropped, so check again now they've left
+	lobby.finishIfEveryoneDone()
+}
 
-	// End the game after the duration of the game
-	time.AfterFunc(time.Duration(lobby.timeLimit)*time.Second, func() {
-		lobby.endGame()
+// KickHandler is sent by the owner to disconnect a player, who is free to log back in
+func KickHandler(event *ClientSent_KickPlayer, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can kick players")
+	} else if event.GetName() == c.name {
+		return fmt.Errorf("the owner can't kick themselves")
+	} else if !lobby.isConnected(event.GetName()) {
+		return fmt.Errorf("%s isn't connected to the lobby", event.GetName())
+	}
+
+	lobby.removePlayer(event.GetName(), CLOSE_KICKED, "kicked by the owner")
+
+	return nil
+}
+
+// BanHandler is sent by the owner to disconnect a player and stop them (by name and address) from
+// logging back in
+func BanHandler(event *ClientSent_BanPlayer, c *Client) error {
+	lobby := c.lobby
+	name := event.GetName()
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can ban players")
+	} else if name == c.name {
+		return fmt.Errorf("the owner can't ban themselves")
+	}
+	user, userExists := lobby.userMapping[name]
+	if !userExists {
+		return fmt.Errorf("%s isn't a member of the lobby", name)
+	}
 
-		endGameLobby(lobby, "Game over!")
-		for client := range lobby.clients {
-			lobby.removeClient(client)
+	lobby.bannedNames[name] = true
+	if user.ip != "" {
+		lobby.bannedIPs[user.ip] = true
+	}
+	for client := range lobby.clients {
+		if client.name == name {
+			lobby.bannedIPs[client.ip] = true
 		}
+	}
 
-		lobby.saveEndedGame()
-		// We can delete the lobby from the map now and have that be GC'd later
-		delete(c.manager.lobbies, lobby.id)
-	})
+	lobby.removePlayer(name, CLOSE_BANNED, "banned by the owner")
+
+	return nil
+}
+
+// RematchHandler is sent by the owner once a game has finished, to play again in the same lobby
+func RematchHandler(event *ClientSent_RequestRematch, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can request a rematch")
+	} else if lobby.gameState != Finished {
+		return fmt.Errorf("game hasn't finished yet")
+	} else if lobby.match.active {
+		return fmt.Errorf("the match's next round starts by itself")
+	}
+
+	lobby.resetGame()
+	lobby.match = matchState{}
+	lobby.broadcast(&ServerSent_Rematch_{Rematch: &ServerSent_Rematch{}})
 
 	return nil
 }
@@ -230,29 +600,44 @@ func GiveAnswerHandler(event *ClientSent_GiveAnswer, c *Client) error {
 	if !c.lobby.inPlay() {
 		return fmt.Errorf("game is not in progress")
 	}
+	if c.lobby.isEliminated(c.name) {
+		return fmt.Errorf("%s has been eliminated", c.name)
+	}
+	if c.lobby.isOutOfTime(c.name) {
+		return fmt.Errorf("%s has run out of time", c.name)
+	}
+	if c.lobby.isRace() {
+		return c.lobby.raceAnswer(c, event.GetAnswer())
+	}
+	if c.lobby.hasRunOutOfProblems(c.name) {
+		return fmt.Errorf("%s has run out of problems", c.name)
+	}
 	user := c.lobby.userMapping[c.name]
 	problem := c.lobby.getLobbyProblems()[c.lobby.CustomOrder[user.questionNumber]]
 
-	if !problem.CheckAnswer(event.GetAnswer()) {
-		c.egress <- protofy(&ServerSent_Wrong{})
+	if !problem.CheckAnswer(event.GetAnswer(), c.lobby.settings.GetJudgeMode()) {
+		c.send(&ServerSent_Wrong{})
+		c.lobby.suddenDeath(c.name)
 		return fmt.Errorf("bad payload in request")
 	}
 
-	// gainedPoints = ⌈latexSolutionLength / 10⌉
-	gainedPoints := int32(math.Ceil(float64(len(*problem.Latex)) / float64(10)))
-	c.lobby.userMapping[c.name] = User{
-		password: user.password, questionNumber: user.questionNumber + 1, score: user.score + gainedPoints,
-	}
-	user = c.lobby.userMapping[c.name]
-
-	var clientsScoreUpdateEvent = &ServerSent_ScoreUpdate_{ScoreUpdate: &ServerSent_ScoreUpdate{Name: &c.name, Score: &user.score}}
+	gainedPoints := c.lobby.handicapped(c.name, c.lobby.pointsFor(problem))
+	now := c.lobby.elapsed()
+	user.solves = append(user.solves, SolvedProblem{*problem.Title, (now - user.problemStartedAt).Seconds()})
+	user.questionNumber++
+	user.score += gainedPoints
+	user.problemStartedAt = now
+	c.lobby.userMapping[c.name] = user
 
-	for client := range c.lobby.clients {
-		client.egress <- protofy(clientsScoreUpdateEvent)
+	c.lobby.broadcast(c.lobby.scoreUpdate(c.name))
+	if c.lobby.checkWin(c.name) {
+		return nil
 	}
+	c.lobby.moveTeammatesOn(c.name)
 
-	if user.questionNumber == int32(len(c.lobby.getLobbyProblems())) {
+	if c.lobby.hasRunOutOfProblems(c.name) {
 		endGame(c, "Ran out of problems!")
+		c.lobby.finishIfEveryoneDone()
 	} else {
 		c.sendClientProblem()
 	}
@@ -261,11 +646,14 @@ func GiveAnswerHandler(event *ClientSent_GiveAnswer, c *Client) error {
 }
 
 func (client *Client) getNewProblem() ServerSent_NewProblem_ {
-	lobby := client.lobby
-	user := lobby.userMapping[client.name]
+	user := client.lobby.userMapping[client.name]
 
+	return client.lobby.getNewProblem(user.questionNumber)
+}
+
+func (lobby *Lobby) getNewProblem(questionNumber int32) ServerSent_NewProblem_ {
 	newProblemBroadcast := ServerSent_NewProblem_{
-		NewProblem: &ServerSent_NewProblem{Problem: lobby.getLobbyProblems()[lobby.CustomOrder[user.questionNumber]]}}
+		NewProblem: &ServerSent_NewProblem{Problem: lobby.getLobbyProblems()[lobby.CustomOrder[questionNumber]]}}
 
 	return newProblemBroadcast
 }
@@ -273,7 +661,71 @@ func (client *Client) getNewProblem() ServerSent_NewProblem_ {
 // @dev Pre-condition: client hasn't run out of problems
 func (client *Client) sendClientProblem() error {
 	newProblemBroadcast := client.getNewProblem()
-	client.egress <- protofy(&newProblemBroadcast)
+	client.send(&newProblemBroadcast)
+
+	// Let anyone watching the player see their new problem too
+	for spectator := range client.lobby.clients {
+		if spectator.following == client.name {
+			client.lobby.sendFollowedProblem(spectator)
+		}
+	}
+
+	return nil
+}
+
+// sendFollowedProblem sends a spectator the problem the player they're following is on, if they're on one
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) sendFollowedProblem(spectator *Client) {
+	name := spectator.following
+	// In a race, spectators see the same round problems as everyone else
+	if name == "" || lobby.isRace() || (!lobby.inPlay() && lobby.gameState != Paused) || lobby.hasRunOutOfProblems(name) {
+		return
+	}
+	problem := lobby.getNewProblem(lobby.userMapping[name].questionNumber).NewProblem.Problem
+	spectator.send(&ServerSent_FollowedProblem_{FollowedProblem: &ServerSent_FollowedProblem{Name: &name, Problem: problem}})
+}
+
+// welcomeSpectator catches a newly connected spectator up with the players, their scores and the game clock
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) welcomeSpectator(spectator *Client) {
+	for client := range lobby.clients {
+		if !client.spectator {
+			spectator.send(&ServerSent_Add{Add: &ServerSent_AddMember{Name: &client.name}})
+		}
+	}
+
+	switch lobby.gameState {
+	case Countdown:
+		spectator.send(lobby.countdownMessage())
+	case InPlay, Paused:
+		spectator.send(&ServerSent_Start{
+			Start: &ServerSent_StartGame{StartTime: timestamppb.New(lobby.startTime.Add(lobby.pausedFor)), Duration: lobby.settings.Duration}})
+		for name := range lobby.userMapping {
+			spectator.send(lobby.scoreUpdate(name))
+		}
+		if lobby.isRace() && lobby.round.open {
+			spectator.send(lobby.roundStartMessage())
+		}
+		lobby.sendEliminations(spectator)
+		if lobby.gameState == Paused {
+			spectator.send(lobby.pausedMessage())
+		}
+	case Finished:
+		spectator.send(&ServerSent_End{&ServerSent_EndGame{}})
+	}
+}
+
+// FollowHandler is sent by a spectator (or eliminated player) to start watching a player
+func FollowHandler(event *ClientSent_Follow, c *Client) error {
+	if !c.spectator && !c.lobby.isEliminated(c.name) {
+		return fmt.Errorf("%s is still playing", c.name)
+	}
+	if _, isMember := c.lobby.userMapping[event.GetName()]; !isMember {
+		return fmt.Errorf("%s isn't a member of the lobby", event.GetName())
+	}
+
+	c.following = event.GetName()
+	c.lobby.sendFollowedProblem(c)
 
 	return nil
 }
@@ -281,14 +733,23 @@ func (client *Client) sendClientProblem() error {
 func RequestProblemHandler(event *ClientSent_RequestProblem, c *Client) error {
 	if !c.lobby.inPlay() {
 		return fmt.Errorf("game is not in progress")
+	} else if c.lobby.isRace() {
+		return fmt.Errorf("problems can't be skipped in a race")
+	} else if c.lobby.isEliminated(c.name) {
+		return fmt.Errorf("%s has been eliminated", c.name)
+	} else if c.lobby.isOutOfTime(c.name) {
+		return fmt.Errorf("%s has run out of time", c.name)
 	}
 	user := c.lobby.userMapping[c.name]
-	user = User{password: user.password, questionNumber: user.questionNumber + 1, score: user.score}
+	user.questionNumber++
+	user.problemStartedAt = c.lobby.elapsed()
 
 	c.lobby.userMapping[c.name] = user
+	c.lobby.moveTeammatesOn(c.name)
 
-	if user.questionNumber == int32(len(c.lobby.getLobbyProblems())) {
+	if c.lobby.hasRunOutOfProblems(c.name) {
 		endGame(c, "Ran out of questions!")
+		c.lobby.finishIfEveryoneDone()
 		return nil
 	}
 
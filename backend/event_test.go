@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// playingLobby builds a lobby partway through a two-problem game between the users, who are all connected
+func playingLobby(t *testing.T, id string, users map[string]User) *Lobby {
+	ctx, cancel := context.WithCancel(context.Background())
+	lobby := NewLobby(ctx, id, id)
+	now := time.Now()
+	lobby.startTime = &now
+	lobby.gameState = InPlay
+	lobby.CustomOrder = []int{0, 1}
+	lobby.armEndTimer()
+
+	for name, user := range users {
+		lobby.userMapping[name] = user
+		lobby.otpMapping[name] = name
+		lobby.clients[NewClient(nil, nil, lobby, newSession(name, false), "")] = true
+	}
+
+	t.Cleanup(func() {
+		for name := range users {
+			lobby.stopLeaveTimer(name)
+		}
+		lobby.stopEndTimer()
+		cancel()
+		os.Remove(filepath.Join("logs", id+".result.json"))
+	})
+	return lobby
+}
+
+// connect gives the player's clients a real connection, for the handlers that write to it directly
+func connect(t *testing.T, lobby *Lobby, name string) {
+	conns := make(chan *websocket.Conn, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocketUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+		}
+		conns <- conn
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	serverConn := <-conns
+	for client := range lobby.clients {
+		if client.name == name {
+			client.connection = serverConn
+		}
+	}
+}
+
+// disconnect drops the player's clients, as if their connection had gone
+func disconnect(lobby *Lobby, name string) {
+	for client := range lobby.clients {
+		if client.name == name {
+			delete(lobby.clients, client)
+		}
+	}
+	lobby.playerDisconnected(name)
+}
+
+func TestLobby_FinishIfEveryoneDone(t *testing.T) {
+	tests := []struct {
+		name  string
+		users map[string]User
+		// players that have disconnected, and those of them that have been gone long enough to have left
+		disconnected []string
+		left         []string
+		// a player the owner kicks, rather than the game just being checked
+		kick     string
+		finished bool
+	}{
+		{
+			name:     "everyone's run out of problems",
+			users:    map[string]User{"alice": {questionNumber: 2}, "bob": {questionNumber: 2}},
+			finished: true,
+		},
+		{
+			name:  "someone still has problems",
+			users: map[string]User{"alice": {questionNumber: 2}, "bob": {questionNumber: 1}},
+		},
+		{
+			name:     "eliminated players don't hold the game up",
+			users:    map[string]User{"alice": {questionNumber: 2}, "bob": {eliminated: true, place: 2}},
+			finished: true,
+		},
+		{
+			name:         "a disconnected player can still come back",
+			users:        map[string]User{"alice": {questionNumber: 2}, "bob": {questionNumber: 1}},
+			disconnected: []string{"bob"},
+		},
+		{
+			name:         "players that have left don't hold the game up",
+			users:        map[string]User{"alice": {questionNumber: 2}, "bob": {questionNumber: 1}},
+			disconnected: []string{"bob"},
+			left:         []string{"bob"},
+			finished:     true,
+		},
+		{
+			name:     "kicked players don't hold the game up",
+			users:    map[string]User{"alice": {questionNumber: 2}, "bob": {questionNumber: 1}},
+			kick:     "bob",
+			finished: true,
+		},
+		{
+			name:         "nobody's left to finish",
+			users:        map[string]User{"alice": {questionNumber: 1}},
+			disconnected: []string{"alice"},
+			left:         []string{"alice"},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lobby := playingLobby(t, fmt.Sprintf("finish-%d", i), test.users)
+			for _, name := range test.disconnected {
+				disconnect(lobby, name)
+			}
+			for _, name := range test.left {
+				lobby.markLeft(name)
+			}
+
+			if test.kick != "" {
+				connect(t, lobby, test.kick)
+				lobby.removePlayer(test.kick, CLOSE_KICKED, "kicked by the owner")
+			} else {
+				lobby.finishIfEveryoneDone()
+			}
+
+			if finished := lobby.gameState == Finished; finished != test.finished {
+				t.Errorf("expected the game to be finished: %v, but it's %s", test.finished, lobby.gameState)
+			}
+		})
+	}
+}
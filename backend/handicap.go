@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// The most an owner can multiply a player's points by
+const MAX_SCORE_MULTIPLIER = 10
+
+// PlayerHandicap is a player's handicap, as it's saved in the results
+type PlayerHandicap struct {
+	ExtraSeconds    int64   `json:"extraSeconds,omitempty"`
+	ScoreMultiplier float32 `json:"scoreMultiplier,omitempty"`
+	HeadStart       int32   `json:"headStart,omitempty"`
+}
+
+// extraTime is how much longer than the game's duration the player has, from their handicap and for joining late
+func (l *Lobby) extraTime(name string) time.Duration {
+	user := l.userMapping[name]
+	return time.Duration(user.handicap.GetExtraTime().GetSeconds())*time.Second + user.lateAllowance
+}
+
+// deadline is when the player's time is up on the game clock
+func (l *Lobby) deadline(name string) time.Duration {
+	return l.duration() + l.extraTime(name)
+}
+
+// isOutOfTime is true once the player's deadline has passed, even if others still have time left
+func (l *Lobby) isOutOfTime(name string) bool {
+	return l.hasTimeLimit() && l.elapsed() >= l.deadline(name)
+}
+
+// gameLength is how long the game goes on for, which is until the player with the most extra time runs out
+func (l *Lobby) gameLength() time.Duration {
+	var mostExtra time.Duration
+	for name := range l.userMapping {
+		if extra := l.extraTime(name); extra > mostExtra {
+			mostExtra = extra
+		}
+	}
+	return l.duration() + mostExtra
+}
+
+// nextDeadline is the next time (on the game clock) that someone's time is up
+func (l *Lobby) nextDeadline() time.Duration {
+	now, next := l.elapsed(), l.gameLength()
+	for name := range l.userMapping {
+		if deadline := l.deadline(name); deadline > now && deadline < next {
+			next = deadline
+		}
+	}
+	return next
+}
+
+// handicapped applies the player's score multiplier to the points they'd otherwise get
+func (l *Lobby) handicapped(name string, points int32) int32 {
+	multiplier := l.userMapping[name].handicap.GetScoreMultiplier()
+	if multiplier <= 0 {
+		return points
+	}
+	return int32(math.Round(float64(points) * float64(multiplier)))
+}
+
+// applyHeadStarts gives every player the points their handicap starts them off with
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) applyHeadStarts() {
+	for name, user := range lobby.userMapping {
+		if headStart := user.handicap.GetHeadStart(); headStart != 0 {
+			user.score = headStart
+			lobby.userMapping[name] = user
+			lobby.broadcast(lobby.scoreUpdate(name))
+		}
+	}
+}
+
+// timeUp ends the game for every connected player whose time was up at the deadline
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) timeUp(deadline time.Duration) {
+	for client := range lobby.clients {
+		if !client.spectator && lobby.deadline(client.name) == deadline {
+			endGame(client, "Time's up!")
+		}
+	}
+}
+
+func (l *Lobby) savedHandicap(name string) *PlayerHandicap {
+	handicap := l.userMapping[name].handicap
+	if handicap == nil {
+		return nil
+	}
+	return &PlayerHandicap{
+		ExtraSeconds:    handicap.GetExtraTime().GetSeconds(),
+		ScoreMultiplier: handicap.GetScoreMultiplier(),
+		HeadStart:       handicap.GetHeadStart(),
+	}
+}
+
+func validateHandicap(handicap *Handicap) error {
+	extraTime := time.Duration(handicap.GetExtraTime().GetSeconds()) * time.Second
+	if extraTime < 0 || extraTime > MAX_GAME_DURATION {
+		return fmt.Errorf("extra time must be between 0 and %v", MAX_GAME_DURATION)
+	} else if handicap.ScoreMultiplier != nil && (handicap.GetScoreMultiplier() <= 0 || handicap.GetScoreMultiplier() > MAX_SCORE_MULTIPLIER) {
+		return fmt.Errorf("score multiplier must be above 0 and at most %d", MAX_SCORE_MULTIPLIER)
+	} else if handicap.GetHeadStart() < 0 {
+		return fmt.Errorf("head start can't be negative")
+	}
+	return nil
+}
+
+// SetHandicapHandler is sent by the owner to give a player a handicap before the game starts
+func SetHandicapHandler(event *ClientSent_SetHandicap, c *Client) error {
+	lobby := c.lobby
+	name := event.GetName()
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can set handicaps")
+	} else if lobby.gameState != WaitingForPlayers {
+		return fmt.Errorf("handicaps can only be changed while waiting for players")
+	}
+	user, isMember := lobby.userMapping[name]
+	if !isMember {
+		return fmt.Errorf("%s isn't a member of the lobby", name)
+	}
+	if err := validateHandicap(event.GetHandicap()); err != nil {
+		c.sendError(err)
+		return err
+	}
+
+	user.handicap = event.GetHandicap()
+	if user.handicap.GetExtraTime().GetSeconds() == 0 && user.handicap.ScoreMultiplier == nil && user.handicap.GetHeadStart() == 0 {
+		user.handicap = nil
+	}
+	lobby.userMapping[name] = user
+
+	lobby.broadcast(lobby.memberUpdate(name))
+	return nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func withExtraTime(seconds int64) User {
+	return User{handicap: &Handicap{ExtraTime: &timestamppb.Timestamp{Seconds: seconds}}}
+}
+
+func TestLobby_HandicapDeadlines(t *testing.T) {
+	users := map[string]User{"alice": {}, "bob": withExtraTime(60), "carol": withExtraTime(120)}
+	lobby := playingLobby(t, "handicap-deadlines", users)
+	startTime := time.Now().Add(-lobby.duration() - 30*time.Second)
+	lobby.startTime = &startTime
+
+	if got, want := lobby.gameLength(), lobby.duration()+120*time.Second; got != want {
+		t.Errorf("expected the game to go on for %v, until carol's out of time, got %v", want, got)
+	}
+	if got, want := lobby.nextDeadline(), lobby.duration()+60*time.Second; got != want {
+		t.Errorf("expected bob's deadline (%v) to be next, got %v", want, got)
+	}
+	for name, outOfTime := range map[string]bool{"alice": true, "bob": false, "carol": false} {
+		if got := lobby.isOutOfTime(name); got != outOfTime {
+			t.Errorf("expected %s to be out of time: %v, got %v", name, outOfTime, got)
+		}
+	}
+}
+
+func TestLobby_EndTimerWithExtraTime(t *testing.T) {
+	tests := []struct {
+		name string
+		// bob's extra time, in seconds; alice has none
+		extraTime int64
+		finished  bool
+	}{
+		{name: "nobody has extra time", finished: true},
+		{name: "someone has extra time", extraTime: 60},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			users := map[string]User{"alice": {questionNumber: 1}, "bob": withExtraTime(test.extraTime)}
+			lobby := playingLobby(t, fmt.Sprintf("handicap-timer-%d", i), users)
+
+			// alice's time is up just after the timer's armed
+			lobby.Lock()
+			startTime := time.Now().Add(-lobby.duration() + 50*time.Millisecond)
+			lobby.startTime = &startTime
+			lobby.armEndTimer()
+			lobby.Unlock()
+
+			time.Sleep(300 * time.Millisecond)
+			lobby.Lock()
+			defer lobby.Unlock()
+
+			if finished := lobby.gameState == Finished; finished != test.finished {
+				t.Fatalf("expected the game to be finished: %v, but it's %s", test.finished, lobby.gameState)
+			} else if test.finished {
+				return
+			}
+			if !lobby.isOutOfTime("alice") || lobby.isOutOfTime("bob") {
+				t.Errorf("expected only alice to be out of time")
+			}
+			if lobby.endTimer == nil {
+				t.Errorf("expected the end timer to be armed for bob's deadline")
+			}
+		})
+	}
+}
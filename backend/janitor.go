@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+var (
+	// lobbyIdleTimeout is how long a lobby can go without anyone connected before it's expired
+	lobbyIdleTimeout = 30 * time.Minute
+	// lobbyUnstartedTimeout is how long a lobby can wait for its first game before it's expired
+	lobbyUnstartedTimeout = 2 * time.Hour
+	// expiredLobbyMemory is how long lobbyStatus remembers that a lobby was expired
+	expiredLobbyMemory = 24 * time.Hour
+)
+
+const JANITOR_INTERVAL = 1 * time.Minute
+
+// janitor periodically expires idle lobbies; this is blocking, so run as a Goroutine
+func (m *Manager) janitor(ctx context.Context) {
+	ticker := time.NewTicker(JANITOR_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			m.collectIdleLobbies(now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// collectIdleLobbies expires (and forgets about) every lobby that's been idle for too long, and any
+// sessions that can no longer be resumed. Expiring a lobby can take a while (its results are saved), so
+// it's done after the manager's lock is released
+func (m *Manager) collectIdleLobbies(now time.Time) {
+	m.RLock()
+	lobbies := make(LobbyList, len(m.lobbies))
+	for id, lobby := range m.lobbies {
+		lobbies[id] = lobby
+	}
+	m.RUnlock()
+
+	idle := make(LobbyList)
+	for id, lobby := range lobbies {
+		lobby.Lock()
+		lobby.pruneSessions(now)
+		if lobby.isIdle(now) {
+			idle[id] = lobby
+		}
+		lobby.Unlock()
+	}
+
+	m.Lock()
+	for id := range idle {
+		delete(m.lobbies, id)
+		m.expired[id] = now
+	}
+	for id, expiredAt := range m.expired {
+		if now.Sub(expiredAt) > expiredLobbyMemory {
+			delete(m.expired, id)
+		}
+	}
+	m.Unlock()
+
+	for id, lobby := range idle {
+		m.expireIdleLobby(id, lobby, now)
+	}
+}
+
+// expireIdleLobby expires a lobby that's been taken out of the manager for being idle, unless it's
+// become active since (someone could've connected with an OTP they already had), in which case it's
+// put back
+func (m *Manager) expireIdleLobby(id string, lobby *Lobby, now time.Time) {
+	lobby.Lock()
+	if !lobby.isIdle(now) {
+		lobby.Unlock()
+
+		m.Lock()
+		if _, replaced := m.lobbies[id]; !replaced {
+			m.lobbies[id] = lobby
+			delete(m.expired, id)
+		}
+		m.Unlock()
+		return
+	}
+	lobby.expire()
+	lobby.Unlock()
+	log.Printf("Expired idle lobby %s\n", id)
+}
+
+// isIdle is true if nobody's been connected to the lobby for a while, or its first game never started;
+// lobbies waiting on a scheduled start are left alone
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) isIdle(now time.Time) bool {
+	if lobby.scheduledStart != nil {
+		return false
+	}
+	if len(lobby.clients) == 0 && now.Sub(lobby.emptySince) > lobbyIdleTimeout {
+		return true
+	}
+	neverStarted := lobby.gameState == WaitingForPlayers && lobby.startTime == nil && len(lobby.pastGames) == 0
+	return neverStarted && now.Sub(lobby.createdAt) > lobbyUnstartedTimeout
+}
+
+// expire shuts the lobby down for good: a game in progress is ended (so its results are saved), everyone
+// still connected is disconnected, and the lobby's goroutines are stopped
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) expire() {
+	if lobby.inPlay() || lobby.gameState == Paused {
+		lobby.finishGame("Lobby expired!")
+	}
+	if lobby.startTimer != nil {
+		lobby.startTimer.Stop()
+	}
+	lobby.stopSchedule()
+	lobby.abandonMatch()
+	if lobby.ownerTimer != nil {
+		lobby.ownerTimer.Stop()
+		lobby.ownerTimer = nil
+	}
+	for name := range lobby.leaveTimers {
+		lobby.stopLeaveTimer(name)
+	}
+
+	lobby.gameState = Expired
+	for client := range lobby.clients {
+		client.closeWithReason(CLOSE_EXPIRED, "lobby expired")
+		lobby.dropClient(client)
+	}
+
+	lobby.cancel()
+}
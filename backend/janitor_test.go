@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestManager_CollectIdleLobbies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := NewManager(ctx)
+	now := time.Now()
+
+	fresh := NewLobby(ctx, "fresh", "fresh")
+	empty := NewLobby(ctx, "empty", "empty")
+	empty.startTime = &now
+	empty.gameState = Finished
+	empty.emptySince = now.Add(-lobbyIdleTimeout - time.Second)
+	unstarted := NewLobby(ctx, "unstarted", "unstarted")
+	unstarted.createdAt = now.Add(-lobbyUnstartedTimeout - time.Second)
+	unstarted.emptySince = now
+
+	for _, lobby := range []*Lobby{fresh, empty, unstarted} {
+		m.lobbies[lobby.id] = lobby
+	}
+
+	m.collectIdleLobbies(now)
+
+	if _, ok := m.lobbies["fresh"]; !ok {
+		t.Error("a freshly created lobby shouldn't be expired")
+	}
+	for _, lobby := range []*Lobby{empty, unstarted} {
+		if _, ok := m.lobbies[lobby.id]; ok {
+			t.Errorf("lobby %s should have been expired", lobby.id)
+		}
+		if _, ok := m.expired[lobby.id]; !ok {
+			t.Errorf("lobby %s should be remembered as expired", lobby.id)
+		}
+		if lobby.gameState != Expired {
+			t.Errorf("lobby %s should be in the expired state, got %s", lobby.id, lobby.gameState)
+		}
+		if lobby.ctx.Err() == nil {
+			t.Errorf("lobby %s should have had its context cancelled", lobby.id)
+		}
+	}
+
+	m.collectIdleLobbies(now.Add(expiredLobbyMemory + time.Second))
+	if _, ok := m.expired["empty"]; ok {
+		t.Error("expired lobbies should be forgotten about eventually")
+	}
+}
+
+func TestManager_ExpireIdleLobby(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := NewManager(ctx)
+	now := time.Now()
+
+	// The lobby was idle when it was taken out of the manager, but someone's connected since
+	lobby := NewLobby(ctx, "revived", "revived")
+	lobby.emptySince = now.Add(-lobbyIdleTimeout - time.Second)
+	m.expired[lobby.id] = now
+	lobby.clients[NewClient(nil, nil, lobby, newSession("alice", false), "")] = true
+
+	m.expireIdleLobby(lobby.id, lobby, now)
+
+	if lobby.gameState == Expired {
+		t.Error("a lobby that's become active shouldn't be expired")
+	}
+	if m.lobbies[lobby.id] != lobby {
+		t.Error("a lobby that's become active should be put back in the manager")
+	}
+	if _, ok := m.expired[lobby.id]; ok {
+		t.Error("a lobby that's become active shouldn't be remembered as expired")
+	}
+}
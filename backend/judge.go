@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// How close (see similarity) an answer has to be to the problem's source to be accepted when judging
+// with SIMILAR
+const SIMILARITY_THRESHOLD = 0.9
+
+// CheckAnswer judges a submitted answer against the problem's source
+func (p *Problem) CheckAnswer(submittedAnswer string, mode JudgeMode) bool {
+	switch mode {
+	case JudgeMode_EXACT:
+		return normaliseLatex(submittedAnswer) == normaliseLatex(p.GetLatex())
+	case JudgeMode_SIMILAR:
+		return similarity(submittedAnswer, p.GetLatex()) >= SIMILARITY_THRESHOLD
+	default:
+		// The client's already compared the rendered answer against the problem
+		return true
+	}
+}
+
+// normaliseLatex strips out whitespace, which (outside of \text) doesn't affect how LaTeX renders
+func normaliseLatex(latex string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, latex)
+}
+
+// similarity is how close two sources are once normalised, from 0 (nothing in common) to 1 (the same),
+// based on their edit distance
+func similarity(a string, b string) float64 {
+	x, y := []rune(normaliseLatex(a)), []rune(normaliseLatex(b))
+	longest := len(x)
+	if len(y) > longest {
+		longest = len(y)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(x, y))/float64(longest)
+}
+
+// editDistance is the Levenshtein distance between two strings
+func editDistance(x []rune, y []rune) int {
+	previous := make([]int, len(y)+1)
+	current := make([]int, len(y)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		current[0] = i
+		for j := 1; j <= len(y); j++ {
+			substitution := previous[j-1]
+			if x[i-1] != y[j-1] {
+				substitution++
+			}
+			current[j] = minInt(substitution, minInt(previous[j]+1, current[j-1]+1))
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(y)]
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
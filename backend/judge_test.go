@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestProblem_CheckAnswer(t *testing.T) {
+	latex := "x = \\frac{-b \\pm \\sqrt{b^2-4ac}}{2a}"
+	problem := &Problem{Latex: &latex}
+
+	tests := []struct {
+		answer string
+		mode   JudgeMode
+		want   bool
+	}{
+		{"anything", JudgeMode_CLIENT, true},
+		{"x=\\frac{-b\\pm\\sqrt{b^2-4ac}}{2a}", JudgeMode_EXACT, true},
+		{"x=\\frac{-b\\pm\\sqrt{b^2-4ac}}{2b}", JudgeMode_EXACT, false},
+		{"x=\\frac{-b\\pm\\sqrt{b^2-4ac}}{2b}", JudgeMode_SIMILAR, true},
+		{"x = y", JudgeMode_SIMILAR, false},
+	}
+
+	for _, test := range tests {
+		if got := problem.CheckAnswer(test.answer, test.mode); got != test.want {
+			t.Errorf("CheckAnswer(%q, %v) = %v, want %v", test.answer, test.mode, got, test.want)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if s := similarity("a b c", "abc"); s != 1 {
+		t.Errorf("sources differing only in whitespace should be the same, got %v", s)
+	}
+	if s := similarity("", ""); s != 1 {
+		t.Errorf("empty sources should be the same, got %v", s)
+	}
+	if s := similarity("abcd", "abxd"); s != 0.75 {
+		t.Errorf("expected one substitution in four characters to give 0.75, got %v", s)
+	}
+	if s := similarity("abc", "xyz"); s != 0 {
+		t.Errorf("sources with nothing in common should give 0, got %v", s)
+	}
+}
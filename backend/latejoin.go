@@ -0,0 +1,56 @@
+package main
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (l *Lobby) isUnderway() bool {
+	return l.inPlay() || l.gameState == Paused
+}
+
+// mustSpectate is true if someone logging in can only watch, as they'd be joining the game late
+func (l *Lobby) mustSpectate(name string) bool {
+	_, isMember := l.userMapping[name]
+	return !isMember && l.isUnderway() && l.settings.GetLateJoin() == LateJoinPolicy_SPECTATE_LATE_JOIN
+}
+
+// joinedLate gives a player who's joined a game that's already going their share of the time they missed
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) joinedLate(name string) {
+	percent := lobby.settings.GetLateJoinAllowance()
+	if !lobby.isUnderway() || !lobby.hasTimeLimit() || percent == 0 {
+		return
+	}
+
+	user := lobby.userMapping[name]
+	user.lateAllowance = (lobby.elapsed() * time.Duration(percent) / 100).Truncate(time.Second)
+	lobby.userMapping[name] = user
+	if user.lateAllowance == 0 {
+		return
+	}
+
+	// The game goes on for longer if nobody else had as much extra time
+	if lobby.inPlay() {
+		lobby.stopEndTimer()
+		lobby.armEndTimer()
+	}
+	lobby.broadcast(lobby.memberUpdate(name))
+}
+
+// shownHandicap is the player's handicap as everyone sees it, which includes any time they got for joining late
+func (l *Lobby) shownHandicap(name string) *Handicap {
+	user := l.userMapping[name]
+	if user.lateAllowance == 0 {
+		return user.handicap
+	}
+
+	handicap := &Handicap{}
+	if user.handicap != nil {
+		handicap = proto.Clone(user.handicap).(*Handicap)
+	}
+	handicap.ExtraTime = &timestamppb.Timestamp{Seconds: int64(l.extraTime(name).Seconds())}
+	return handicap
+}
@@ -2,18 +2,24 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net/http"
+	"path/filepath"
 )
 
 func init() { log.SetFlags(log.Lshortfile | log.LstdFlags) }
 
 func main() {
+	flag.DurationVar(&lobbyIdleTimeout, "lobby-idle-timeout", lobbyIdleTimeout, "how long a lobby can have nobody connected before it's expired")
+	flag.DurationVar(&lobbyUnstartedTimeout, "lobby-unstarted-timeout", lobbyUnstartedTimeout, "how long a lobby can wait for its first game before it's expired")
+	flag.Parse()
+
 	// Initialize problems -- done at the start so there's not excessive latency on the first game
 	GetProblems()
 	println("Starting server...")
 
-	// Create a root ctx and a CancelFunc which can be used to cancel retentionMap goroutine
+	// Create a root ctx and a CancelFunc which can be used to cancel the lobbies' and janitor's goroutines
 	rootCtx := context.Background()
 	ctx, cancel := context.WithCancel(rootCtx)
 
@@ -38,9 +44,18 @@ func setupAPI(ctx context.Context) {
 	http.Handle("/", http.FileServer(http.Dir("./frontend/public")))
 	http.Handle("/logs/", http.StripPrefix("/logs/", http.FileServer(http.Dir("./logs"))))
 	http.HandleFunc("/createLobby", manager.createLobbyHandler)
+	http.HandleFunc("/lobbies", manager.listLobbiesHandler)
 
 	// Routes used for lobby
 	http.HandleFunc("/login", manager.loginHandler)
 	http.HandleFunc("/ws", manager.serveWS)
 	http.HandleFunc("/lobbyStatus", manager.lobbyStatus)
+
+	// Routes used for solo practice, which is saved under ./practice
+	practice := NewPracticeStore(filepath.Join(".", "practice"))
+	http.HandleFunc("/practice/login", practice.loginHandler)
+	http.HandleFunc("/practice/start", practice.startHandler)
+	http.HandleFunc("/practice/answer", practice.answerHandler)
+	http.HandleFunc("/practice/skip", practice.skipHandler)
+	http.HandleFunc("/practice/history", practice.historyHandler)
 }
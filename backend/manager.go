@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"sync"
 	"time"
 
@@ -39,29 +40,49 @@ var (
 	ErrEventNotSupported = errors.New("this event type is not supported")
 )
 
-func (p *Problem) CheckAnswer(submittedAnswer string) bool {
-	return true // TODO: Implement this (check against answer)
-}
-
 type User struct {
 	password       string
 	questionNumber int32
 	score          int32
+	// address the user last logged in from
+	ip   string
+	team string
+	// eliminated players watch the rest of the game, having finished in place (1 being the winner)
+	eliminated bool
+	place      int32
+	handicap   *Handicap
+	// whether the player's ready for the next game to start
+	ready bool
+	// whether the player's been disconnected for longer than the grace period
+	left bool
+	// extra time the player got for joining the game after it started
+	lateAllowance time.Duration
+
+	// game clock reading when the current problem was handed out
+	problemStartedAt time.Duration
+	solves           []SolvedProblem
 }
 
 type GameState string
 
 const (
 	WaitingForPlayers GameState = "waiting"
+	Countdown         GameState = "countdown"
 	InPlay            GameState = "playing"
+	Paused            GameState = "paused"
 	Finished          GameState = "finished"
+	Expired           GameState = "expired"
 	DNE               GameState = "dne"
 )
 
+// How long the owner can be disconnected before ownership passes to someone else
+const OWNER_GRACE_PERIOD = 30 * time.Second
+
 type Lobby struct {
 	id        string
 	name      string
-	timeLimit int
+	createdAt time.Time
+	settings  *LobbySettings
 	startTime *time.Time
 	owner     *string
 	gameState GameState
@@ -70,19 +91,67 @@ type Lobby struct {
 	userMapping map[string]User
 	// otp to username
 	otpMapping map[string]string
+	// otps handed out to spectators, who aren't in userMapping
+	spectatorOtps map[string]bool
+	// usernames and addresses banned by the owner, for the rest of the lobby's lifetime
+	bannedNames map[string]bool
+	bannedIPs   map[string]bool
 
 	useCustom      bool
 	CustomProblems []*Problem
 	CustomOrder    []int
 
+	// startTimer starts the game once the pre-game countdown is over
+	startTimer *time.Timer
+	// when the owner's scheduled the game to start by itself, and scheduleTimer that starts it
+	scheduledStart *time.Time
+	scheduleTimer  *time.Timer
+	// ownerTimer hands ownership to someone else if the owner stays disconnected for too long
+	ownerTimer *time.Timer
+	// leaveTimers count disconnected players as having left once they've been gone too long
+	leaveTimers map[string]*time.Timer
+	// names of the lobby's teams, in the order the owner gave them
+	teams []string
+	// the most recent chat messages, oldest first, and each player's chat rate limit
+	chatHistory  []*ServerSent_Chat_
+	chatLimiters map[string]*rateLimiter
+	// each player's rate limit on the drafts they send while typing
+	draftLimiters map[string]*rateLimiter
+	// sessions clients can resume after reconnecting, by token
+	sessions map[string]*session
+
+	// endTimer ends the game in progress once its time limit is reached
+	endTimer *time.Timer
+	// the race round being played (or just played), and roundTimer moves it on, in race mode
+	round      raceRound
+	roundTimer *time.Timer
+	// eliminationTimer knocks out the lowest scorers when the game clock reaches nextElimination
+	eliminationTimer *time.Timer
+	nextElimination  time.Duration
+	// whoever won the game outright, if anyone has
+	winner string
+	// the match being played, if the game's a round of one
+	match matchState
+	// when the game was last paused, and how long it's been paused for in total
+	pausedAt  time.Time
+	pausedFor time.Duration
+	// results of the games previously played in this lobby, oldest first
+	pastGames []SavedGameResult
+
 	clients ClientList // TODO: investigate needs to be merged with userMapping (?)
+	// when the last client left, if nobody's connected
+	emptySince time.Time
 
 	// Using a syncMutex here to be able to lcok state before editing clients
 	// Could also use Channels to block
 	sync.RWMutex
 
 	// otps is a map of allowed OTP to accept connections from
-	otps RetentionMap
+	otps *RetentionMap
+
+	// ctx is cancelled once the lobby has expired, stopping its goroutines
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // UUID to Lobby map
@@ -92,6 +161,11 @@ type LobbyList map[string]*Lobby
 type Manager struct {
 	lobbies LobbyList
 	ctx     context.Context
+	// UUIDs of lobbies the janitor has expired, and when
+	expired map[string]time.Time
+
+	// Guards lobbies, which is read and written by concurrent HTTP handlers
+	sync.RWMutex
 }
 
 // NewManager is used to initalize all the values inside the manager
@@ -99,17 +173,33 @@ func NewManager(ctx context.Context) *Manager {
 	m := &Manager{
 		lobbies: make(LobbyList),
 		ctx:     ctx,
+		expired: make(map[string]time.Time),
 	}
+
+	go m.janitor(ctx)
+
 	return m
 }
 
 func NewLobby(ctx context.Context, name string, id string) *Lobby {
+	ctx, cancel := context.WithCancel(ctx)
+	now := time.Now()
+
 	l := &Lobby{
 		userMapping:    make(map[string]User),
 		otpMapping:     make(map[string]string),
-		timeLimit:      600,
+		spectatorOtps:  make(map[string]bool),
+		bannedNames:    make(map[string]bool),
+		bannedIPs:      make(map[string]bool),
+		chatLimiters:   make(map[string]*rateLimiter),
+		draftLimiters:  make(map[string]*rateLimiter),
+		sessions:       make(map[string]*session),
+		leaveTimers:    make(map[string]*time.Timer),
+		settings:       defaultSettings(),
 		id:             id,
 		name:           name,
+		createdAt:      now,
+		emptySince:     now,
 		owner:          nil,
 		gameState:      WaitingForPlayers,
 		startTime:      nil,
@@ -118,31 +208,248 @@ func NewLobby(ctx context.Context, name string, id string) *Lobby {
 		CustomProblems: nil,
 		CustomOrder:    nil,
 		useCustom:      false,
+		ctx:            ctx,
+		cancel:         cancel,
 	}
 
 	return l
 }
 
-func (lobby *Lobby) startGame() {
+func (lobby *Lobby) startCountdown() {
 	if lobby.gameState != WaitingForPlayers {
 		panic("Game is already in progress")
 	}
+	lobby.gameState = Countdown
+}
+
+func (lobby *Lobby) startGame() {
+	if lobby.gameState != Countdown {
+		panic("Game isn't counting down")
+	}
 	lobby.gameState = InPlay
 }
 
 func (lobby *Lobby) endGame() {
-	if lobby.gameState != InPlay {
+	if lobby.gameState != InPlay && lobby.gameState != Paused {
 		panic("Game isn't in progress")
 	}
 	lobby.gameState = Finished
 }
 
+func (lobby *Lobby) pauseGame() {
+	if lobby.gameState != InPlay {
+		panic("Game isn't in progress")
+	}
+	lobby.gameState = Paused
+	lobby.pausedAt = time.Now()
+}
+
+func (lobby *Lobby) resumeGame() {
+	if lobby.gameState != Paused {
+		panic("Game isn't paused")
+	}
+	lobby.gameState = InPlay
+	lobby.pausedFor += time.Since(lobby.pausedAt)
+}
+
 func (lobby *Lobby) inPlay() bool {
 	return lobby.gameState == InPlay
 }
 
+// elapsed is the game clock: how long the game has been played for, not counting pauses
+func (lobby *Lobby) elapsed() time.Duration {
+	now := time.Now()
+	if lobby.gameState == Paused {
+		now = lobby.pausedAt
+	}
+	return now.Sub(*lobby.startTime) - lobby.pausedFor
+}
+
+// remaining is how much of the game's time limit is left on the game clock, or 0 if it doesn't have one
+func (lobby *Lobby) remaining() time.Duration {
+	if !lobby.hasTimeLimit() {
+		return 0
+	}
+	return lobby.duration() - lobby.elapsed()
+}
+
+func (lobby *Lobby) isOwner(name string) bool {
+	return lobby.owner != nil && *lobby.owner == name
+}
+
+// isConnected is true if the player has at least one client connected to the lobby
+func (lobby *Lobby) isConnected(name string) bool {
+	for client := range lobby.clients {
+		if client.name == name && !client.spectator {
+			return true
+		}
+	}
+	return false
+}
+
+// isBanned checks a user against the lobby's bans; the owner is never banned, even if they share
+// an address with someone who was
+func (lobby *Lobby) isBanned(name string, ip string) bool {
+	if lobby.isOwner(name) {
+		return false
+	}
+	return lobby.bannedNames[name] || lobby.bannedIPs[ip]
+}
+
+// checkAdmission applies the lobby's settings to someone logging in; players who are already
+// members of the lobby can always log back in
+func (lobby *Lobby) checkAdmission(name string) error {
+	if _, isMember := lobby.userMapping[name]; isMember {
+		return nil
+	}
+
+	for client := range lobby.clients {
+		if client.spectator && client.name == name {
+			return errors.New("name is taken by a spectator")
+		}
+	}
+
+	maxPlayers := int(lobby.settings.GetMaxPlayers())
+	if maxPlayers != 0 && len(lobby.userMapping) >= maxPlayers {
+		return errors.New("lobby is full")
+	}
+	if lobby.isUnderway() && lobby.settings.GetLateJoin() == LateJoinPolicy_DENY_LATE_JOIN {
+		return errors.New("game is already in progress")
+	}
+	return nil
+}
+
+// setOwner hands ownership of the lobby over, letting everyone know
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) setOwner(name string) {
+	lobby.owner = &name
+	lobby.broadcast(&ServerSent_OwnerChanged_{OwnerChanged: &ServerSent_OwnerChanged{Name: &name}})
+}
+
+// watchOwner starts the grace period for a disconnected owner, or cancels it if they're back
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) watchOwner() {
+	if lobby.gameState == Expired {
+		return
+	}
+	if lobby.owner == nil || lobby.isConnected(*lobby.owner) {
+		if lobby.ownerTimer != nil {
+			lobby.ownerTimer.Stop()
+			lobby.ownerTimer = nil
+		}
+		return
+	}
+	if lobby.ownerTimer != nil {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(OWNER_GRACE_PERIOD, func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		if lobby.ownerTimer != timer {
+			return
+		}
+		lobby.ownerTimer = nil
+		if lobby.isConnected(*lobby.owner) {
+			return
+		}
+
+		// Promote whoever's been connected the longest; if nobody is, the next to connect re-arms this
+		var successor *Client
+		for client := range lobby.clients {
+			if client.spectator {
+				continue
+			}
+			if successor == nil || client.connectedAt.Before(successor.connectedAt) {
+				successor = client
+			}
+		}
+		if successor != nil {
+			lobby.setOwner(successor.name)
+		}
+	})
+	lobby.ownerTimer = timer
+}
+
+// resetGame takes a finished lobby back to waiting for players, keeping its members (and their
+// connections) but clearing everyone's progress from the previous game
+func (lobby *Lobby) resetGame() {
+	if lobby.gameState != Finished {
+		panic("Game hasn't finished")
+	}
+	for name, user := range lobby.userMapping {
+		user.questionNumber = 0
+		user.score = 0
+		user.problemStartedAt = 0
+		user.solves = nil
+		user.eliminated = false
+		user.place = 0
+		user.ready = false
+		user.lateAllowance = 0
+		lobby.userMapping[name] = user
+	}
+	for client := range lobby.clients {
+		if !client.spectator {
+			client.following = ""
+		}
+	}
+	lobby.startTime = nil
+	lobby.startTimer = nil
+	lobby.endTimer = nil
+	lobby.round = raceRound{}
+	lobby.roundTimer = nil
+	lobby.eliminationTimer = nil
+	lobby.nextElimination = 0
+	lobby.winner = ""
+	lobby.pausedFor = 0
+	lobby.CustomOrder = nil
+	lobby.gameState = WaitingForPlayers
+}
+
+// broadcast sends a message to every client connected to the lobby
+func (lobby *Lobby) broadcast(message isServerSent_Message) {
+	for client := range lobby.clients {
+		client.send(message)
+	}
+	// Anyone that's dropped out gets it if they come back
+	for _, session := range lobby.disconnectedSessions() {
+		session.record(message)
+	}
+}
+
+func (m *Manager) getLobby(id string) (*Lobby, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	lobby, lobbyExists := m.lobbies[id]
+	return lobby, lobbyExists
+}
+
 // routeEvent is used to make sure the correct event goes into the correct handler
 func (m *Manager) routeEvent(event *ClientSent, c *Client) {
+	// Handlers (and the lobby's timers) all hold the lobby lock, so they don't race each other
+	c.lobby.Lock()
+	defer c.lobby.Unlock()
+
+	// The client might've been removed (or the lobby expired) while the event waited on the lock
+	if _, ok := c.lobby.clients[c]; !ok {
+		return
+	}
+
+	// Spectators can only choose who to follow
+	if c.spectator {
+		if follow, ok := event.Message.(*ClientSent_Follow_); ok {
+			if err := FollowHandler(follow.Follow, c); err != nil {
+				log.Println(err)
+			}
+		} else {
+			log.Println("Spectator " + c.name + " in lobby " + c.lobby.name + " can't send " + reflect.TypeOf(event.Message).String())
+		}
+		return
+	}
+
 	// Check if Handler is present in Map
 	switch event.Message.(type) {
 	case *ClientSent_RequestStart_:
@@ -152,6 +459,78 @@ func (m *Manager) routeEvent(event *ClientSent, c *Client) {
 		GiveAnswerHandler(event.GetAnswer(), c)
 	case *ClientSent_RequestProblem_:
 		RequestProblemHandler(event.GetRequestProblem(), c)
+	case *ClientSent_RequestRematch_:
+		if err := RematchHandler(event.GetRequestRematch(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_Pause:
+		if err := PauseHandler(event.GetPause(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_Resume:
+		if err := ResumeHandler(event.GetResume(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_RequestEnd_:
+		if err := EndGameHandler(event.GetRequestEnd(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_TransferOwnership_:
+		if err := TransferOwnershipHandler(event.GetTransferOwnership(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_Kick:
+		if err := KickHandler(event.GetKick(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_Ban:
+		if err := BanHandler(event.GetBan(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_UpdateSettings_:
+		if err := UpdateSettingsHandler(event.GetUpdateSettings(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_DefineTeams_:
+		if err := DefineTeamsHandler(event.GetDefineTeams(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_JoinTeam_:
+		if err := JoinTeamHandler(event.GetJoinTeam(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_AssignTeam_:
+		if err := AssignTeamHandler(event.GetAssignTeam(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_Follow_:
+		if err := FollowHandler(event.GetFollow(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_SetHandicap_:
+		if err := SetHandicapHandler(event.GetSetHandicap(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_Draft_:
+		if err := DraftHandler(event.GetDraft(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_Chat_:
+		if err := ChatHandler(event.GetChat(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_SetReady_:
+		if err := SetReadyHandler(event.GetSetReady(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_ScheduleStart_:
+		if err := ScheduleStartHandler(event.GetScheduleStart(), c); err != nil {
+			log.Println(err)
+		}
+	case *ClientSent_SetChatDisabled_:
+		if err := SetChatDisabledHandler(event.GetSetChatDisabled(), c); err != nil {
+			log.Println(err)
+		}
 	}
 
 	log.Print(time.Now().Format("2006/01/02 15:04:05") +
@@ -181,82 +560,167 @@ func (m *Manager) loginHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	lobbyId := req.LobbyId
-	lobby, lobbyExists := m.lobbies[*lobbyId]
+	lobby, lobbyExists := m.getLobby(*lobbyId)
 	if !lobbyExists {
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
 
-	// Hashed password from the request
-	hashedReqPassword, err := HashPassword(*req.Password)
+	ip := requestIP(r)
+	lobby.RLock()
+	banned := lobby.isBanned(*req.Username, ip)
+	lobby.RUnlock()
+	if banned {
+		http.Error(w, "banned from this lobby", http.StatusForbidden)
+		return
+	}
+
+	lobby.RLock()
+	spectate := req.GetSpectator() || lobby.mustSpectate(*req.Username)
+	lobby.RUnlock()
+	if spectate {
+		m.spectatorLogin(w, lobby, *req.Username)
+		return
+	}
+
+	lobby.RLock()
+	err = lobby.checkAdmission(*req.Username)
+	lobby.RUnlock()
 	if err != nil {
-		log.Println(err)
+		http.Error(w, err.Error(), http.StatusForbidden)
 		return
 	}
 
+	// Hashing (and checking a hash) is slow, so it's done without holding the lock
+	password := req.GetPassword()
+	lobby.RLock()
 	user, userExists := lobby.userMapping[*req.Username]
+	lobby.RUnlock()
+	var hashedPassword string
+	if userExists {
+		if !CheckPasswordHash(&password, user.password) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		hashedPassword = user.password
+	} else if hashedPassword, err = HashPassword(password); err != nil {
+		log.Println(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	lobby.Lock()
+	// The lobby may have changed while the password was being hashed (filling up, or the game starting), so
+	// everything's checked again before the player's added
+	if lobby.isBanned(*req.Username, ip) {
+		lobby.Unlock()
+		http.Error(w, "banned from this lobby", http.StatusForbidden)
+		return
+	} else if lobby.mustSpectate(*req.Username) {
+		lobby.Unlock()
+		m.spectatorLogin(w, lobby, *req.Username)
+		return
+	} else if err := lobby.checkAdmission(*req.Username); err != nil {
+		lobby.Unlock()
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	user, userExists = lobby.userMapping[*req.Username]
 	if !userExists {
-		user.password = hashedReqPassword
 		// Initialise user
-		lobby.userMapping[*req.Username] = user
+		user.password = hashedPassword
+	} else if user.password != hashedPassword && !CheckPasswordHash(&password, user.password) {
+		// Someone else joined with the name while the password was being hashed
+		lobby.Unlock()
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	user.ip = ip
+	lobby.userMapping[*req.Username] = user
+	if !userExists {
+		lobby.joinedLate(*req.Username)
 	}
 
-	// authenticate user / verify access token
-	if CheckPasswordHash(req.Password, user.password) {
-		// If authentication passes, set the owner of the lobby
-		isOwner := false
-		if lobby.owner == nil {
-			lobby.owner = req.Username
-			isOwner = true
-		}
+	// The first player in sets the owner of the lobby
+	isOwner := false
+	if lobby.owner == nil {
+		lobby.owner = req.Username
+		isOwner = true
+	}
 
-		// add a new OTP
-		otp := lobby.otps.NewOTP()
-		lobby.otpMapping[otp.Key] = *req.Username
+	// add a new OTP
+	otp := lobby.otps.NewOTP()
+	lobby.otpMapping[otp.Key] = *req.Username
+	lobby.Unlock()
 
-		// format to return otp in to the frontend
-		resp := LoginResponse{
-			Otp:     &otp.Key,
-			IsOwner: &isOwner,
-		}
+	// format to return otp in to the frontend
+	resp := LoginResponse{
+		Otp:     &otp.Key,
+		IsOwner: &isOwner,
+	}
 
-		data, err := proto.Marshal(&resp)
-		if err != nil {
-			log.Println(err)
-			return
-		}
-		// return a response to the authenticated user with the OTP
-		w.WriteHeader(http.StatusOK)
-		w.Write(data)
+	data, err := proto.Marshal(&resp)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	// return a response to the authenticated user with the OTP
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// spectatorLogin hands out an OTP to a spectator; spectators don't have passwords, as they're not members
+// of the lobby, but they can't take the name of someone who is
+func (m *Manager) spectatorLogin(w http.ResponseWriter, lobby *Lobby, name string) {
+	lobby.Lock()
+	_, isMember := lobby.userMapping[name]
+	if isMember {
+		lobby.Unlock()
+		http.Error(w, "name is taken by a player", http.StatusConflict)
 		return
 	}
+	otp := lobby.otps.NewOTP()
+	lobby.otpMapping[otp.Key] = name
+	lobby.spectatorOtps[otp.Key] = true
+	lobby.Unlock()
+
+	isOwner, isSpectator := false, true
+	resp := LoginResponse{
+		Otp:         &otp.Key,
+		IsOwner:     &isOwner,
+		IsSpectator: &isSpectator,
+	}
 
-	// failure to auth
-	w.WriteHeader(http.StatusUnauthorized)
+	data, err := proto.Marshal(&resp)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
 }
 
 // serveWS is a HTTP Handler that the has the Manager that allows connections
 func (m *Manager) serveWS(w http.ResponseWriter, r *http.Request) {
 
-	// Grab the OTP in the Get param
+	// Grab the OTP in the Get param, or the session being resumed by a client that's reconnecting
 	otp := r.URL.Query().Get("otp")
-	if otp == "" {
+	token := r.URL.Query().Get("session")
+	if otp == "" && token == "" {
 		log.Println("asdasd")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
 	lobbyName := r.URL.Query().Get("l")
-	lobby, lobbyExists := m.lobbies[lobbyName]
+	lobby, lobbyExists := m.getLobby(lobbyName)
 	if !lobbyExists {
 		log.Println("aswwwwwdasd")
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
-	if lobby.gameState == Finished {
-		log.Println("asdasd")
-		// Don't allow users to connected if the game has ended
-		w.WriteHeader(http.StatusGone)
+	if token != "" {
+		m.resumeWS(w, r, lobby, token)
 		return
 	}
 
@@ -267,7 +731,21 @@ func (m *Manager) serveWS(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log.Println("New connection from ", lobby.otpMapping[otp] == *lobby.owner)
+	// The OTP might've been handed out before its user was banned, or the lobby expired
+	lobby.RLock()
+	banned := lobby.isBanned(lobby.otpMapping[otp], requestIP(r))
+	expired := lobby.gameState == Expired
+	session := newSession(lobby.otpMapping[otp], lobby.spectatorOtps[otp])
+	lobby.RUnlock()
+	if banned {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	} else if expired {
+		w.WriteHeader(http.StatusGone)
+		return
+	}
+
+	log.Println("New connection from ", session.name, " (owner: ", lobby.isOwner(session.name), ")")
 	// Begin by upgrading the HTTP request
 	conn, err := websocketUpgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -277,22 +755,51 @@ func (m *Manager) serveWS(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create New Client
-	client := NewClient(conn, m, lobby, otp)
+	client := NewClient(conn, m, lobby, session, requestIP(r))
 	// Add the newly created client to the manager
 	lobby.addClient(client)
 
 	go client.readMessages()
 	go client.writeMessages()
 
-	if lobby.gameState == WaitingForPlayers {
+	lobby.Lock()
+	defer lobby.Unlock()
+
+	client.deliver(session.message(false))
+	client.send(lobby.settingsMessage())
+	if lobby.hasTeams() {
+		client.send(lobby.teamsMessage())
+	}
+	client.send(lobby.snapshot())
+	if !client.spectator {
+		lobby.playerConnected(client.name)
+	}
+	lobby.sendChatHistory(client)
+	if lobby.scheduledStart != nil {
+		client.send(lobby.scheduledMessage())
+	}
+
+	if client.spectator {
+		lobby.welcomeSpectator(client)
+		return
+	}
+
+	// A finished lobby stays open (waiting on a rematch), so it's joined like a waiting one
+	if lobby.gameState == WaitingForPlayers || lobby.gameState == Countdown || lobby.gameState == Finished {
 		// Sending newMember events to all joined clients
 		var broadMessage = ServerSent_Add{Add: &ServerSent_AddMember{Name: &client.name}}
 		for c := range client.lobby.clients {
+			if c.spectator {
+				if c.name != client.name {
+					c.send(&broadMessage)
+				}
+				continue
+			}
 			if c.name != client.name {
-				c.egress <- protofy(&broadMessage)
+				c.send(&broadMessage)
 			}
 			var existingClientMessage = ServerSent_Add{Add: &ServerSent_AddMember{Name: &c.name}}
-			client.egress <- protofy(&existingClientMessage)
+			client.send(&existingClientMessage)
 			// var smallMessage = NewMemberEvent{c.name}
 			// data, err = json.Marshal(smallMessage)
 			// if err != nil {
@@ -302,14 +809,79 @@ func (m *Manager) serveWS(w http.ResponseWriter, r *http.Request) {
 			// var smallOutgoingEvent = Event{EventNewMember, data}
 			// client.egress <- smallOutgoingEvent
 		}
-	} else if lobby.gameState == InPlay {
+		if lobby.gameState == Countdown {
+			client.send(lobby.countdownMessage())
+		} else if lobby.gameState == Finished {
+			client.send(&ServerSent_End{&ServerSent_EndGame{}})
+		}
+	} else if lobby.gameState == InPlay || lobby.gameState == Paused {
+		// Shift the start time by the time spent paused, so the client's clock lines up with ours
 		var outgoingEvent = &ServerSent_Start{
-			Start: &ServerSent_StartGame{StartTime: timestamppb.New(*lobby.startTime), Duration: &timestamppb.Timestamp{Seconds: int64(lobby.timeLimit)}}}
-		client.egress <- protofy(outgoingEvent)
+			Start: &ServerSent_StartGame{StartTime: timestamppb.New(lobby.startTime.Add(lobby.pausedFor)), Duration: lobby.settings.Duration}}
+		client.send(outgoingEvent)
+		lobby.sendEliminations(client)
+
+		if lobby.isEliminated(client.name) {
+			// Eliminated players only watch, so they don't get a problem
+		} else if lobby.isRace() {
+			if lobby.round.open {
+				client.send(lobby.roundStartMessage())
+			}
+		} else if lobby.isOutOfTime(client.name) {
+			endGame(client, "Time's up!")
+		} else if lobby.hasRunOutOfProblems(client.name) {
+			endGame(client, "Ran out of problems!")
+		} else {
+			client.sendClientProblem()
+		}
+
+		if lobby.gameState == Paused {
+			client.send(lobby.pausedMessage())
+		}
+	}
+}
+
+// resumeWS reconnects a client to its session, so it gets exactly the messages it missed (rather than logging
+// in again and starting over)
+func (m *Manager) resumeWS(w http.ResponseWriter, r *http.Request, lobby *Lobby, token string) {
+	seq, err := strconv.ParseUint(r.URL.Query().Get("seq"), 10, 64)
+	if err != nil {
+		http.Error(w, "seq must be the last message received", http.StatusBadRequest)
+		return
+	}
+
+	lobby.RLock()
+	session, err := lobby.resumableSession(token, seq, requestIP(r))
+	expired := lobby.gameState == Expired
+	lobby.RUnlock()
+	if expired {
+		w.WriteHeader(http.StatusGone)
+		return
+	} else if err == errResumeTooLate {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
 
-		newProblemMessage := client.getNewProblem()
-		client.egress <- protofy(&newProblemMessage)
+	log.Println("Resumed connection from ", session.name, " at ", seq)
+	conn, err := websocketUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Println(err)
+		return
 	}
+
+	client := NewClient(conn, m, lobby, session, requestIP(r))
+	// The missed messages are written as they're replayed, so the writer has to be going first
+	go client.writeMessages()
+	if err := lobby.resumeClient(client, seq); err != nil {
+		client.closeWithReason(CLOSE_RESUME_FAILED, err.Error())
+		client.close()
+		conn.Close()
+		return
+	}
+	go client.readMessages()
 }
 
 func (m *Manager) lobbyStatus(w http.ResponseWriter, r *http.Request) {
@@ -325,15 +897,22 @@ func (m *Manager) lobbyStatus(w http.ResponseWriter, r *http.Request) {
 	}
 	type response struct {
 		Status GameState `json:"lobbyStatus"`
+		// When the game's scheduled to start by itself, if it is
+		ScheduledStart *time.Time `json:"scheduledStart,omitempty"`
 	}
 
-	lobby, lobbyExists := m.lobbies[req.Id]
+	lobby, lobbyExists := m.getLobby(req.Id)
 
 	if !lobbyExists {
 		var resp response
-		// If lobby doesn't exist in map, either it's been deleted or the game has ended
+		// If lobby doesn't exist in map, either it's been expired, deleted or the game has ended
+		m.RLock()
+		_, wasExpired := m.expired[req.Id]
+		m.RUnlock()
 		logFilepath := filepath.Join(".", "logs", req.Id+".result.json")
-		if _, err := os.Stat(logFilepath); errors.Is(err, os.ErrNotExist) {
+		if wasExpired {
+			resp = response{Status: Expired}
+		} else if _, err := os.Stat(logFilepath); errors.Is(err, os.ErrNotExist) {
 			resp = response{Status: DNE}
 		} else {
 			resp = response{Status: Finished}
@@ -348,7 +927,9 @@ func (m *Manager) lobbyStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := response{Status: lobby.gameState}
+	lobby.RLock()
+	resp := response{Status: lobby.gameState, ScheduledStart: lobby.scheduledStart}
+	lobby.RUnlock()
 	data, err := json.Marshal(resp)
 	if err != nil {
 		log.Println(err)
@@ -382,7 +963,23 @@ func (m *Manager) createLobbyHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	id := uuid.New().String()
-	m.lobbies[id] = NewLobby(m.ctx, *req.LobbyName, id)
+	lobby := NewLobby(m.ctx, *req.LobbyName, id)
+	if req.GetIsPublic() {
+		lobby.settings.Visibility = Visibility_PUBLIC.Enum()
+	}
+	if req.ScheduledStart != nil {
+		lobby.Lock()
+		err = lobby.schedule(req.GetScheduledStart().AsTime())
+		lobby.Unlock()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	m.Lock()
+	m.lobbies[id] = lobby
+	m.Unlock()
 
 	resp := CreateLobbyRes{
 		LobbyId: &id,
@@ -405,9 +1002,18 @@ func (m *Lobby) addClient(client *Client) bool {
 	m.Lock()
 	defer m.Unlock()
 
+	m.attachClient(client)
+	return true
+}
+
+// attachClient is addClient for callers already holding the lobby lock
+func (m *Lobby) attachClient(client *Client) {
 	// Add Client
 	m.clients[client] = true
-	return true
+	m.sessions[client.session.token] = client.session
+	client.session.client = client
+	m.emptySince = time.Time{}
+	m.watchOwner()
 }
 
 // removeClient will remove the client and clean up
@@ -415,11 +1021,32 @@ func (m *Lobby) removeClient(client *Client) {
 	m.Lock()
 	defer m.Unlock()
 
+	m.dropClient(client)
+}
+
+// dropClient is removeClient for callers already holding the lobby lock
+func (m *Lobby) dropClient(client *Client) {
 	// Check if Client exists, then delete it
 	if _, ok := m.clients[client]; ok {
 		// close connection
+		client.close()
 		client.connection.Close()
 		// remove
 		delete(m.clients, client)
+		if client.session.client == client {
+			client.session.client = nil
+			client.session.disconnectedAt = time.Now()
+			client.session.following = client.following
+		}
+		if len(m.clients) == 0 {
+			m.emptySince = time.Now()
+		}
+		m.watchOwner()
+		if !client.spectator {
+			m.playerDisconnected(client.name)
+		}
+
+		// Whoever's left might all be waiting on the player that just left
+		m.finishIfEveryoneDone()
 	}
 }
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLobby_GameClock(t *testing.T) {
+	tests := []struct {
+		name string
+		// how long ago the game started, and (if it's paused) was last paused
+		startedAgo time.Duration
+		pausedAgo  time.Duration
+		pausedFor  time.Duration
+		elapsed    time.Duration
+	}{
+		{name: "never paused", startedAgo: 30 * time.Second, elapsed: 30 * time.Second},
+		{name: "paused", startedAgo: 30 * time.Second, pausedAgo: 20 * time.Second, elapsed: 10 * time.Second},
+		{name: "resumed after a pause", startedAgo: 30 * time.Second, pausedFor: 20 * time.Second, elapsed: 10 * time.Second},
+		{name: "paused a second time", startedAgo: 60 * time.Second, pausedFor: 20 * time.Second, pausedAgo: 10 * time.Second, elapsed: 30 * time.Second},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			lobby := NewLobby(ctx, "clock", "clock")
+			now := time.Now()
+			startTime := now.Add(-test.startedAgo)
+			lobby.startTime = &startTime
+			lobby.pausedFor = test.pausedFor
+			lobby.gameState = InPlay
+			if test.pausedAgo != 0 {
+				lobby.gameState = Paused
+				lobby.pausedAt = now.Add(-test.pausedAgo)
+			}
+
+			if got := lobby.elapsed().Round(time.Second); got != test.elapsed {
+				t.Errorf("expected %v on the game clock, got %v", test.elapsed, got)
+			}
+			if got, want := lobby.remaining().Round(time.Second), lobby.duration()-test.elapsed; got != want {
+				t.Errorf("expected %v left, got %v", want, got)
+			}
+
+			// Time spent paused doesn't count once the game's resumed either
+			if lobby.gameState == Paused {
+				lobby.resumeGame()
+				if got := lobby.elapsed().Round(time.Second); got != test.elapsed {
+					t.Errorf("expected %v on the game clock after resuming, got %v", test.elapsed, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Bounds on a match's length, and the break between its rounds
+const MAX_MATCH_ROUNDS = 20
+const DEFAULT_MATCH_INTERMISSION = 10 * time.Second
+const MAX_MATCH_INTERMISSION = 5 * time.Minute
+
+// matchState keeps track of a match between its rounds, each of which is a game of its own
+type matchState struct {
+	// active while the match is being played, including between rounds
+	active bool
+	// the (0-based) round being played, or that's just been played
+	round int
+	// the lobby's settings from before they were overridden for a round
+	settings *LobbySettings
+	// each round's standings, and everyone's total score across the rounds played so far
+	rounds [][]*Standing
+	totals map[string]int32
+	// timer starts the next round once the intermission's over
+	timer *time.Timer
+	// calledOff is set once the owner's ended the match early, so the round being played is its last
+	calledOff bool
+}
+
+// MatchScore is a player's score in a match's standings, as it's saved in the results
+type MatchScore struct {
+	Name  string `json:"name"`
+	Score int32  `json:"score"`
+}
+
+type MatchResult struct {
+	// The standings after each round played so far, first round first
+	Rounds [][]MatchScore `json:"rounds"`
+	// Everyone's total score across the rounds
+	Overall []MatchScore `json:"overall"`
+}
+
+func (l *Lobby) isMatch() bool {
+	return l.settings.GetMatch().GetRounds() > 1
+}
+
+func (l *Lobby) matchIntermission() time.Duration {
+	if l.settings.GetMatch().GetIntermission() == nil {
+		return DEFAULT_MATCH_INTERMISSION
+	}
+	return time.Duration(l.settings.GetMatch().GetIntermission().GetSeconds()) * time.Second
+}
+
+// roundSettings is what the lobby's settings are overridden with for the round
+func roundSettings(settings *LobbySettings, round int) *LobbySettings {
+	overrides := settings.GetMatch().GetRoundSettings()
+	if round >= len(overrides) {
+		return settings
+	}
+	merged := proto.Clone(settings).(*LobbySettings)
+	proto.Merge(merged, overrides[round])
+	replaceTimestamps(merged, overrides[round])
+	replaceRepeated(merged, overrides[round])
+	return merged
+}
+
+// standingsOf orders the scores highest first (and by name when they're tied)
+func standingsOf(scores map[string]int32) []*Standing {
+	standings := make([]*Standing, 0, len(scores))
+	for name, score := range scores {
+		name, score := name, score
+		standings = append(standings, &Standing{Name: &name, Score: &score})
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].GetScore() != standings[j].GetScore() {
+			return standings[i].GetScore() > standings[j].GetScore()
+		}
+		return standings[i].GetName() < standings[j].GetName()
+	})
+	return standings
+}
+
+// beginMatch starts a match off at its first round, if the lobby's set up to play one
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) beginMatch() {
+	if !lobby.isMatch() || lobby.match.active {
+		return
+	}
+	lobby.match = matchState{active: true, settings: lobby.settings, totals: make(map[string]int32)}
+	lobby.useRoundSettings()
+}
+
+// useRoundSettings swaps the lobby's settings for the ones the current round is played with
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) useRoundSettings() {
+	settings := roundSettings(lobby.match.settings, lobby.match.round)
+	if settings == lobby.settings {
+		return
+	}
+	lobby.settings = settings
+	lobby.broadcast(lobby.settingsMessage())
+}
+
+// recordMatchRound adds the round that's just finished to the match's standings
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) recordMatchRound() {
+	if !lobby.match.active {
+		return
+	}
+	scores := make(map[string]int32)
+	for name, user := range lobby.userMapping {
+		scores[name] = user.score
+		lobby.match.totals[name] += user.score
+	}
+	lobby.match.rounds = append(lobby.match.rounds, standingsOf(scores))
+}
+
+func (l *Lobby) matchRoundEnd() *ServerSent_MatchRoundEnd {
+	round, rounds := int32(l.match.round+1), l.match.settings.GetMatch().GetRounds()
+	return &ServerSent_MatchRoundEnd{
+		Round:          &round,
+		Rounds:         &rounds,
+		RoundStandings: l.match.rounds[l.match.round],
+		Standings:      standingsOf(l.match.totals),
+	}
+}
+
+// endMatchRound lets everyone know the standings after the round, then either starts the next round
+// after the intermission, or finishes the match
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) endMatchRound() {
+	if !lobby.match.active {
+		return
+	}
+
+	message := lobby.matchRoundEnd()
+	if message.GetRound() >= message.GetRounds() || lobby.match.calledOff {
+		lobby.finishMatch(message)
+		return
+	}
+
+	intermission := lobby.matchIntermission()
+	message.NextRoundStart = timestamppb.New(time.Now().Add(intermission))
+	lobby.broadcast(&ServerSent_MatchRoundEnd_{MatchRoundEnd: message})
+
+	var timer *time.Timer
+	timer = time.AfterFunc(intermission, func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		if lobby.match.timer != timer || !lobby.match.active || lobby.gameState != Finished {
+			return
+		}
+		lobby.match.timer = nil
+		lobby.nextMatchRound()
+	})
+	lobby.match.timer = timer
+}
+
+// finishMatch puts the lobby back to how it was set up before the match, and lets everyone know the final
+// standings
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) finishMatch(message *ServerSent_MatchRoundEnd) {
+	lobby.match.active = false
+	lobby.settings = lobby.match.settings
+	lobby.broadcast(lobby.settingsMessage())
+	lobby.broadcast(&ServerSent_MatchRoundEnd_{MatchRoundEnd: message})
+}
+
+// callOffMatch ends the match early, at the end of the round being played, or straight away if it's
+// between rounds
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) callOffMatch() {
+	if !lobby.match.active {
+		return
+	} else if lobby.gameState != Finished {
+		lobby.match.calledOff = true
+		return
+	}
+
+	if lobby.match.timer != nil {
+		lobby.match.timer.Stop()
+		lobby.match.timer = nil
+	}
+	lobby.finishMatch(lobby.matchRoundEnd())
+}
+
+// nextMatchRound resets the lobby and starts the match's next round
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) nextMatchRound() {
+	lobby.resetGame()
+	lobby.broadcast(&ServerSent_Rematch_{Rematch: &ServerSent_Rematch{}})
+
+	lobby.match.round++
+	lobby.useRoundSettings()
+	if err := lobby.start(&ClientSent_RequestStart{Force: proto.Bool(true)}); err != nil {
+		log.Printf("Failed to start round %d of the match in lobby %s: %v\n", lobby.match.round+1, lobby.id, err)
+		lobby.abandonMatch()
+		lobby.broadcast(lobby.settingsMessage())
+	}
+}
+
+// abandonMatch stops the match where it is, putting the lobby's settings back to how they were before it
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) abandonMatch() {
+	if lobby.match.timer != nil {
+		lobby.match.timer.Stop()
+		lobby.match.timer = nil
+	}
+	if lobby.match.active {
+		lobby.settings = lobby.match.settings
+		lobby.match.active = false
+	}
+}
+
+func (l *Lobby) matchResult() *MatchResult {
+	result := &MatchResult{Overall: savedStandings(standingsOf(l.match.totals))}
+	for _, standings := range l.match.rounds {
+		result.Rounds = append(result.Rounds, savedStandings(standings))
+	}
+	return result
+}
+
+func savedStandings(standings []*Standing) []MatchScore {
+	saved := make([]MatchScore, 0, len(standings))
+	for _, standing := range standings {
+		saved = append(saved, MatchScore{standing.GetName(), standing.GetScore()})
+	}
+	return saved
+}
+
+func (l *Lobby) validateMatch(settings *LobbySettings) error {
+	match := settings.GetMatch()
+	intermission := time.Duration(match.GetIntermission().GetSeconds()) * time.Second
+	if match.GetRounds() < 0 || match.GetRounds() > MAX_MATCH_ROUNDS {
+		return fmt.Errorf("a match can have at most %d rounds", MAX_MATCH_ROUNDS)
+	} else if intermission < 0 || intermission > MAX_MATCH_INTERMISSION {
+		return fmt.Errorf("the break between rounds must be between 0 and %v", MAX_MATCH_INTERMISSION)
+	} else if len(match.GetRoundSettings()) > int(match.GetRounds()) {
+		return fmt.Errorf("there are settings for more rounds than the match has")
+	}
+
+	for round, overrides := range match.GetRoundSettings() {
+		if overrides.Match != nil {
+			return fmt.Errorf("round %d's settings can't set up another match", round+1)
+		}
+		merged := proto.Clone(roundSettings(settings, round)).(*LobbySettings)
+		merged.Match = nil
+		if err := l.validateSettings(merged); err != nil {
+			return fmt.Errorf("round %d: %v", round+1, err)
+		}
+	}
+	return nil
+}
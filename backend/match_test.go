@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRoundSettings(t *testing.T) {
+	var rounds int32 = 3
+	base := &LobbySettings{
+		Duration: &timestamppb.Timestamp{Seconds: 600},
+		Match: &MatchSettings{Rounds: &rounds, RoundSettings: []*LobbySettings{
+			{},
+			{Duration: &timestamppb.Timestamp{Seconds: 60}},
+		}},
+	}
+
+	if got := roundSettings(base, 1).GetDuration().GetSeconds(); got != 60 {
+		t.Errorf("the second round should be overridden to 60s, got %ds", got)
+	}
+	if got := roundSettings(base, 2).GetDuration().GetSeconds(); got != 600 {
+		t.Errorf("a round without overrides should keep the lobby's duration, got %ds", got)
+	}
+	if base.GetDuration().GetSeconds() != 600 {
+		t.Error("overriding a round's settings shouldn't change the lobby's")
+	}
+}
+
+func TestStandingsOf(t *testing.T) {
+	standings := standingsOf(map[string]int32{"carol": 3, "alice": 5, "bob": 3})
+
+	want := []string{"alice", "bob", "carol"}
+	for i, standing := range standings {
+		if standing.GetName() != want[i] {
+			t.Fatalf("expected %v (highest first, then by name), got %v at %d", want, standing.GetName(), i)
+		}
+	}
+}
+
+func TestEndGameHandler_CallsOffMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		// whether the first round's over, and the match is waiting to start the next
+		betweenRounds bool
+	}{
+		{name: "during a round"},
+		{name: "between rounds", betweenRounds: true},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lobby := playingLobby(t, fmt.Sprintf("call-off-%d", i), map[string]User{"alice": {score: 3}})
+			owner := "alice"
+			lobby.owner = &owner
+			settings := lobby.settings
+			settings.Match = &MatchSettings{Rounds: proto.Int32(3)}
+			lobby.beginMatch()
+			if test.betweenRounds {
+				lobby.finishGame("Round over!")
+			}
+
+			var client *Client
+			for c := range lobby.clients {
+				client = c
+			}
+			if err := EndGameHandler(&ClientSent_RequestEnd{}, client); err != nil {
+				t.Fatalf("expected the owner to be able to end the match, got %v", err)
+			}
+
+			if lobby.gameState != Finished {
+				t.Errorf("expected the game to be over, but it's %s", lobby.gameState)
+			}
+			if lobby.match.active || lobby.match.timer != nil {
+				t.Error("expected the match to have been called off, rather than going on to the next round")
+			}
+			if lobby.settings != settings {
+				t.Error("expected the lobby's settings to be put back to how they were before the match")
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// member is what the member list shows about a member
+func (l *Lobby) member(name string) *Member {
+	user := l.userMapping[name]
+	presence := Presence_DISCONNECTED
+	if l.isConnected(name) {
+		presence = Presence_CONNECTED
+	} else if user.left {
+		presence = Presence_LEFT
+	}
+	return &Member{Name: &name, Handicap: l.shownHandicap(name), Ready: &user.ready, Score: &user.score, Presence: &presence}
+}
+
+func (l *Lobby) memberUpdate(name string) *ServerSent_MemberUpdate_ {
+	return &ServerSent_MemberUpdate_{MemberUpdate: &ServerSent_MemberUpdate{Member: l.member(name)}}
+}
+
+// snapshot is everything a client needs to catch up with the lobby, whenever it (re)connects
+func (l *Lobby) snapshot() *ServerSent_Snapshot {
+	names := make([]string, 0, len(l.userMapping))
+	for name := range l.userMapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	state := string(l.gameState)
+	snapshot := &ServerSent_LobbySnapshot{Settings: l.settings, Owner: l.owner, State: &state}
+	for _, name := range names {
+		snapshot.Members = append(snapshot.Members, l.member(name))
+	}
+	if l.isUnderway() && l.hasTimeLimit() {
+		snapshot.Remaining = &timestamppb.Timestamp{Seconds: int64(l.remaining() / time.Second)}
+	}
+	if len(l.match.rounds) > 0 {
+		snapshot.MatchStandings = standingsOf(l.match.totals)
+	}
+	return &ServerSent_Snapshot{Snapshot: snapshot}
+}
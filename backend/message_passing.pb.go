@@ -21,6 +21,421 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Visibility int32
+
+const (
+	Visibility_PRIVATE Visibility = 0
+	Visibility_PUBLIC  Visibility = 1
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "PRIVATE",
+		1: "PUBLIC",
+	}
+	Visibility_value = map[string]int32{
+		"PRIVATE": 0,
+		"PUBLIC":  1,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_passing_proto_enumTypes[0].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_message_passing_proto_enumTypes[0]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Visibility) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Visibility(num)
+	return nil
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{0}
+}
+
+type ScoringMode int32
+
+const (
+	// ⌈latexSolutionLength / 10⌉ points per problem
+	ScoringMode_LENGTH ScoringMode = 0
+	// One point per problem
+	ScoringMode_FLAT ScoringMode = 1
+)
+
+// Enum value maps for ScoringMode.
+var (
+	ScoringMode_name = map[int32]string{
+		0: "LENGTH",
+		1: "FLAT",
+	}
+	ScoringMode_value = map[string]int32{
+		"LENGTH": 0,
+		"FLAT":   1,
+	}
+)
+
+func (x ScoringMode) Enum() *ScoringMode {
+	p := new(ScoringMode)
+	*p = x
+	return p
+}
+
+func (x ScoringMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoringMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_passing_proto_enumTypes[1].Descriptor()
+}
+
+func (ScoringMode) Type() protoreflect.EnumType {
+	return &file_message_passing_proto_enumTypes[1]
+}
+
+func (x ScoringMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ScoringMode) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ScoringMode(num)
+	return nil
+}
+
+// Deprecated: Use ScoringMode.Descriptor instead.
+func (ScoringMode) EnumDescriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{1}
+}
+
+type JudgeMode int32
+
+const (
+	// Trust the client's comparison of the rendered answer
+	JudgeMode_CLIENT JudgeMode = 0
+	// The answer has to match the problem's source, ignoring whitespace
+	JudgeMode_EXACT JudgeMode = 1
+	// The answer has to be close enough to the problem's source
+	JudgeMode_SIMILAR JudgeMode = 2
+)
+
+// Enum value maps for JudgeMode.
+var (
+	JudgeMode_name = map[int32]string{
+		0: "CLIENT",
+		1: "EXACT",
+		2: "SIMILAR",
+	}
+	JudgeMode_value = map[string]int32{
+		"CLIENT":  0,
+		"EXACT":   1,
+		"SIMILAR": 2,
+	}
+)
+
+func (x JudgeMode) Enum() *JudgeMode {
+	p := new(JudgeMode)
+	*p = x
+	return p
+}
+
+func (x JudgeMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JudgeMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_passing_proto_enumTypes[2].Descriptor()
+}
+
+func (JudgeMode) Type() protoreflect.EnumType {
+	return &file_message_passing_proto_enumTypes[2]
+}
+
+func (x JudgeMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *JudgeMode) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = JudgeMode(num)
+	return nil
+}
+
+// Deprecated: Use JudgeMode.Descriptor instead.
+func (JudgeMode) EnumDescriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{2}
+}
+
+type LateJoinPolicy int32
+
+const (
+	LateJoinPolicy_ALLOW_LATE_JOIN LateJoinPolicy = 0
+	LateJoinPolicy_DENY_LATE_JOIN  LateJoinPolicy = 1
+	// Anyone who isn't already a player can only watch a game that's going
+	LateJoinPolicy_SPECTATE_LATE_JOIN LateJoinPolicy = 2
+)
+
+// Enum value maps for LateJoinPolicy.
+var (
+	LateJoinPolicy_name = map[int32]string{
+		0: "ALLOW_LATE_JOIN",
+		1: "DENY_LATE_JOIN",
+		2: "SPECTATE_LATE_JOIN",
+	}
+	LateJoinPolicy_value = map[string]int32{
+		"ALLOW_LATE_JOIN":    0,
+		"DENY_LATE_JOIN":     1,
+		"SPECTATE_LATE_JOIN": 2,
+	}
+)
+
+func (x LateJoinPolicy) Enum() *LateJoinPolicy {
+	p := new(LateJoinPolicy)
+	*p = x
+	return p
+}
+
+func (x LateJoinPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LateJoinPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_passing_proto_enumTypes[3].Descriptor()
+}
+
+func (LateJoinPolicy) Type() protoreflect.EnumType {
+	return &file_message_passing_proto_enumTypes[3]
+}
+
+func (x LateJoinPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *LateJoinPolicy) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = LateJoinPolicy(num)
+	return nil
+}
+
+// Deprecated: Use LateJoinPolicy.Descriptor instead.
+func (LateJoinPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{3}
+}
+
+type GameMode int32
+
+const (
+	// Everyone works through the problems at their own pace
+	GameMode_STANDARD GameMode = 0
+	// Everyone races to solve the same problem, moving on together
+	GameMode_RACE GameMode = 1
+)
+
+// Enum value maps for GameMode.
+var (
+	GameMode_name = map[int32]string{
+		0: "STANDARD",
+		1: "RACE",
+	}
+	GameMode_value = map[string]int32{
+		"STANDARD": 0,
+		"RACE":     1,
+	}
+)
+
+func (x GameMode) Enum() *GameMode {
+	p := new(GameMode)
+	*p = x
+	return p
+}
+
+func (x GameMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_passing_proto_enumTypes[4].Descriptor()
+}
+
+func (GameMode) Type() protoreflect.EnumType {
+	return &file_message_passing_proto_enumTypes[4]
+}
+
+func (x GameMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *GameMode) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = GameMode(num)
+	return nil
+}
+
+// Deprecated: Use GameMode.Descriptor instead.
+func (GameMode) EnumDescriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{4}
+}
+
+// How many players have to be ready before the owner can start the game
+type ReadyRequirement int32
+
+const (
+	ReadyRequirement_NO_READY_CHECK ReadyRequirement = 0
+	ReadyRequirement_ALL_READY      ReadyRequirement = 1
+	// At least ready_quorum percent of the players
+	ReadyRequirement_QUORUM_READY ReadyRequirement = 2
+)
+
+// Enum value maps for ReadyRequirement.
+var (
+	ReadyRequirement_name = map[int32]string{
+		0: "NO_READY_CHECK",
+		1: "ALL_READY",
+		2: "QUORUM_READY",
+	}
+	ReadyRequirement_value = map[string]int32{
+		"NO_READY_CHECK": 0,
+		"ALL_READY":      1,
+		"QUORUM_READY":   2,
+	}
+)
+
+func (x ReadyRequirement) Enum() *ReadyRequirement {
+	p := new(ReadyRequirement)
+	*p = x
+	return p
+}
+
+func (x ReadyRequirement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReadyRequirement) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_passing_proto_enumTypes[5].Descriptor()
+}
+
+func (ReadyRequirement) Type() protoreflect.EnumType {
+	return &file_message_passing_proto_enumTypes[5]
+}
+
+func (x ReadyRequirement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ReadyRequirement) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ReadyRequirement(num)
+	return nil
+}
+
+// Deprecated: Use ReadyRequirement.Descriptor instead.
+func (ReadyRequirement) EnumDescriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{5}
+}
+
+type Presence int32
+
+const (
+	Presence_CONNECTED    Presence = 0
+	Presence_DISCONNECTED Presence = 1
+	// Disconnected for longer than the lobby's grace period
+	Presence_LEFT Presence = 2
+)
+
+// Enum value maps for Presence.
+var (
+	Presence_name = map[int32]string{
+		0: "CONNECTED",
+		1: "DISCONNECTED",
+		2: "LEFT",
+	}
+	Presence_value = map[string]int32{
+		"CONNECTED":    0,
+		"DISCONNECTED": 1,
+		"LEFT":         2,
+	}
+)
+
+func (x Presence) Enum() *Presence {
+	p := new(Presence)
+	*p = x
+	return p
+}
+
+func (x Presence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Presence) Descriptor() protoreflect.EnumDescriptor {
+	return file_message_passing_proto_enumTypes[6].Descriptor()
+}
+
+func (Presence) Type() protoreflect.EnumType {
+	return &file_message_passing_proto_enumTypes[6]
+}
+
+func (x Presence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Presence) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Presence(num)
+	return nil
+}
+
+// Deprecated: Use Presence.Descriptor instead.
+func (Presence) EnumDescriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{6}
+}
+
 type Problem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -84,25 +499,22 @@ func (x *Problem) GetTitle() string {
 	return ""
 }
 
-type ServerSent struct {
+type RaceSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Message:
-	//
-	//	*ServerSent_Remove
-	//	*ServerSent_Add
-	//	*ServerSent_Start
-	//	*ServerSent_NewProblem_
-	//	*ServerSent_End
-	//	*ServerSent_ScoreUpdate_
-	//	*ServerSent_Wrong
-	Message isServerSent_Message `protobuf_oneof:"message"`
+	// How long a round can go on for; 0 means only the game's duration limits it
+	RoundTimeLimit *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=round_time_limit,json=roundTimeLimit" json:"round_time_limit,omitempty"`
+	// The break between one round ending and the next starting
+	Intermission *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=intermission" json:"intermission,omitempty"`
+	// Points for solving the round's problem first, second, ... (the round ends once they've all been
+	// given out); if empty, only the first solve counts, and it scores as usual
+	PositionPoints []int32 `protobuf:"varint,3,rep,name=position_points,json=positionPoints" json:"position_points,omitempty"`
 }
 
-func (x *ServerSent) Reset() {
-	*x = ServerSent{}
+func (x *RaceSettings) Reset() {
+	*x = RaceSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_passing_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -110,13 +522,13 @@ func (x *ServerSent) Reset() {
 	}
 }
 
-func (x *ServerSent) String() string {
+func (x *RaceSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerSent) ProtoMessage() {}
+func (*RaceSettings) ProtoMessage() {}
 
-func (x *ServerSent) ProtoReflect() protoreflect.Message {
+func (x *RaceSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_message_passing_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -128,218 +540,119 @@ func (x *ServerSent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerSent.ProtoReflect.Descriptor instead.
-func (*ServerSent) Descriptor() ([]byte, []int) {
+// Deprecated: Use RaceSettings.ProtoReflect.Descriptor instead.
+func (*RaceSettings) Descriptor() ([]byte, []int) {
 	return file_message_passing_proto_rawDescGZIP(), []int{1}
 }
 
-func (m *ServerSent) GetMessage() isServerSent_Message {
-	if m != nil {
-		return m.Message
+func (x *RaceSettings) GetRoundTimeLimit() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RoundTimeLimit
 	}
 	return nil
 }
 
-func (x *ServerSent) GetRemove() *ServerSent_RemoveMember {
-	if x, ok := x.GetMessage().(*ServerSent_Remove); ok {
-		return x.Remove
+func (x *RaceSettings) GetIntermission() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Intermission
 	}
 	return nil
 }
 
-func (x *ServerSent) GetAdd() *ServerSent_AddMember {
-	if x, ok := x.GetMessage().(*ServerSent_Add); ok {
-		return x.Add
+func (x *RaceSettings) GetPositionPoints() []int32 {
+	if x != nil {
+		return x.PositionPoints
 	}
 	return nil
 }
 
-func (x *ServerSent) GetStart() *ServerSent_StartGame {
-	if x, ok := x.GetMessage().(*ServerSent_Start); ok {
-		return x.Start
-	}
-	return nil
-}
+// The lowest scorers are knocked out (and left watching) until there's one player left
+type EliminationSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ServerSent) GetNewProblem() *ServerSent_NewProblem {
-	if x, ok := x.GetMessage().(*ServerSent_NewProblem_); ok {
-		return x.NewProblem
-	}
-	return nil
+	Enabled *bool `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	// How often players are eliminated; 0 means after every round, which is only allowed in race mode
+	Interval *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=interval" json:"interval,omitempty"`
+	// How many players are eliminated each time (at least one)
+	PerElimination *int32 `protobuf:"varint,3,opt,name=per_elimination,json=perElimination" json:"per_elimination,omitempty"`
+	// A wrong answer eliminates the player straight away
+	SuddenDeath *bool `protobuf:"varint,4,opt,name=sudden_death,json=suddenDeath" json:"sudden_death,omitempty"`
 }
 
-func (x *ServerSent) GetEnd() *ServerSent_EndGame {
-	if x, ok := x.GetMessage().(*ServerSent_End); ok {
-		return x.End
+func (x *EliminationSettings) Reset() {
+	*x = EliminationSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ServerSent) GetScoreUpdate() *ServerSent_ScoreUpdate {
-	if x, ok := x.GetMessage().(*ServerSent_ScoreUpdate_); ok {
-		return x.ScoreUpdate
-	}
-	return nil
+func (x *EliminationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ServerSent) GetWrong() *ServerSent_WrongAnswer {
-	if x, ok := x.GetMessage().(*ServerSent_Wrong); ok {
-		return x.Wrong
+func (*EliminationSettings) ProtoMessage() {}
+
+func (x *EliminationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isServerSent_Message interface {
-	isServerSent_Message()
+// Deprecated: Use EliminationSettings.ProtoReflect.Descriptor instead.
+func (*EliminationSettings) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{2}
 }
 
-type ServerSent_Remove struct {
-	Remove *ServerSent_RemoveMember `protobuf:"bytes,1,opt,name=remove,oneof"`
-}
-
-type ServerSent_Add struct {
-	Add *ServerSent_AddMember `protobuf:"bytes,2,opt,name=add,oneof"`
-}
-
-type ServerSent_Start struct {
-	Start *ServerSent_StartGame `protobuf:"bytes,3,opt,name=start,oneof"`
-}
-
-type ServerSent_NewProblem_ struct {
-	NewProblem *ServerSent_NewProblem `protobuf:"bytes,4,opt,name=new_problem,json=newProblem,oneof"`
-}
-
-type ServerSent_End struct {
-	End *ServerSent_EndGame `protobuf:"bytes,5,opt,name=end,oneof"`
-}
-
-type ServerSent_ScoreUpdate_ struct {
-	ScoreUpdate *ServerSent_ScoreUpdate `protobuf:"bytes,6,opt,name=score_update,json=scoreUpdate,oneof"`
-}
-
-type ServerSent_Wrong struct {
-	Wrong *ServerSent_WrongAnswer `protobuf:"bytes,7,opt,name=wrong,oneof"`
-}
-
-func (*ServerSent_Remove) isServerSent_Message() {}
-
-func (*ServerSent_Add) isServerSent_Message() {}
-
-func (*ServerSent_Start) isServerSent_Message() {}
-
-func (*ServerSent_NewProblem_) isServerSent_Message() {}
-
-func (*ServerSent_End) isServerSent_Message() {}
-
-func (*ServerSent_ScoreUpdate_) isServerSent_Message() {}
-
-func (*ServerSent_Wrong) isServerSent_Message() {}
-
-type ClientSent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Message:
-	//
-	//	*ClientSent_RequestStart_
-	//	*ClientSent_Answer
-	//	*ClientSent_RequestProblem_
-	Message isClientSent_Message `protobuf_oneof:"message"`
-}
-
-func (x *ClientSent) Reset() {
-	*x = ClientSent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ClientSent) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ClientSent) ProtoMessage() {}
-
-func (x *ClientSent) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ClientSent.ProtoReflect.Descriptor instead.
-func (*ClientSent) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{2}
-}
-
-func (m *ClientSent) GetMessage() isClientSent_Message {
-	if m != nil {
-		return m.Message
+func (x *EliminationSettings) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *ClientSent) GetRequestStart() *ClientSent_RequestStart {
-	if x, ok := x.GetMessage().(*ClientSent_RequestStart_); ok {
-		return x.RequestStart
+func (x *EliminationSettings) GetInterval() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Interval
 	}
 	return nil
 }
 
-func (x *ClientSent) GetAnswer() *ClientSent_GiveAnswer {
-	if x, ok := x.GetMessage().(*ClientSent_Answer); ok {
-		return x.Answer
+func (x *EliminationSettings) GetPerElimination() int32 {
+	if x != nil && x.PerElimination != nil {
+		return *x.PerElimination
 	}
-	return nil
+	return 0
 }
 
-func (x *ClientSent) GetRequestProblem() *ClientSent_RequestProblem {
-	if x, ok := x.GetMessage().(*ClientSent_RequestProblem_); ok {
-		return x.RequestProblem
+func (x *EliminationSettings) GetSuddenDeath() bool {
+	if x != nil && x.SuddenDeath != nil {
+		return *x.SuddenDeath
 	}
-	return nil
-}
-
-type isClientSent_Message interface {
-	isClientSent_Message()
-}
-
-type ClientSent_RequestStart_ struct {
-	RequestStart *ClientSent_RequestStart `protobuf:"bytes,1,opt,name=request_start,json=requestStart,oneof"`
-}
-
-type ClientSent_Answer struct {
-	Answer *ClientSent_GiveAnswer `protobuf:"bytes,2,opt,name=answer,oneof"`
-}
-
-type ClientSent_RequestProblem_ struct {
-	RequestProblem *ClientSent_RequestProblem `protobuf:"bytes,3,opt,name=request_problem,json=requestProblem,oneof"`
+	return false
 }
 
-func (*ClientSent_RequestStart_) isClientSent_Message() {}
-
-func (*ClientSent_Answer) isClientSent_Message() {}
-
-func (*ClientSent_RequestProblem_) isClientSent_Message() {}
-
-type CreateLobbyReq struct {
+// The game ends as soon as a player reaches either target (0 meaning that target isn't used)
+type WinCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyName *string `protobuf:"bytes,1,req,name=lobby_name,json=lobbyName" json:"lobby_name,omitempty"`
+	TargetSolves *int32 `protobuf:"varint,1,opt,name=target_solves,json=targetSolves" json:"target_solves,omitempty"`
+	TargetScore  *int32 `protobuf:"varint,2,opt,name=target_score,json=targetScore" json:"target_score,omitempty"`
 }
 
-func (x *CreateLobbyReq) Reset() {
-	*x = CreateLobbyReq{}
+func (x *WinCondition) Reset() {
+	*x = WinCondition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_passing_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -347,13 +660,13 @@ func (x *CreateLobbyReq) Reset() {
 	}
 }
 
-func (x *CreateLobbyReq) String() string {
+func (x *WinCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLobbyReq) ProtoMessage() {}
+func (*WinCondition) ProtoMessage() {}
 
-func (x *CreateLobbyReq) ProtoReflect() protoreflect.Message {
+func (x *WinCondition) ProtoReflect() protoreflect.Message {
 	mi := &file_message_passing_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,28 +678,41 @@ func (x *CreateLobbyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLobbyReq.ProtoReflect.Descriptor instead.
-func (*CreateLobbyReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WinCondition.ProtoReflect.Descriptor instead.
+func (*WinCondition) Descriptor() ([]byte, []int) {
 	return file_message_passing_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateLobbyReq) GetLobbyName() string {
-	if x != nil && x.LobbyName != nil {
-		return *x.LobbyName
+func (x *WinCondition) GetTargetSolves() int32 {
+	if x != nil && x.TargetSolves != nil {
+		return *x.TargetSolves
 	}
-	return ""
+	return 0
 }
 
-type CreateLobbyRes struct {
+func (x *WinCondition) GetTargetScore() int32 {
+	if x != nil && x.TargetScore != nil {
+		return *x.TargetScore
+	}
+	return 0
+}
+
+// A match is a series of games (rounds), played one after another with the scores added up across them
+type MatchSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LobbyId *string `protobuf:"bytes,1,req,name=lobby_id,json=lobbyId" json:"lobby_id,omitempty"`
+	// 0 or 1 means there's just the one game
+	Rounds *int32 `protobuf:"varint,1,opt,name=rounds" json:"rounds,omitempty"`
+	// The break between one round ending and the next starting
+	Intermission *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=intermission" json:"intermission,omitempty"`
+	// Settings that override the lobby's for the first round, second round, ...
+	RoundSettings []*LobbySettings `protobuf:"bytes,3,rep,name=round_settings,json=roundSettings" json:"round_settings,omitempty"`
 }
 
-func (x *CreateLobbyRes) Reset() {
-	*x = CreateLobbyRes{}
+func (x *MatchSettings) Reset() {
+	*x = MatchSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_passing_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,13 +720,13 @@ func (x *CreateLobbyRes) Reset() {
 	}
 }
 
-func (x *CreateLobbyRes) String() string {
+func (x *MatchSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLobbyRes) ProtoMessage() {}
+func (*MatchSettings) ProtoMessage() {}
 
-func (x *CreateLobbyRes) ProtoReflect() protoreflect.Message {
+func (x *MatchSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_message_passing_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -412,30 +738,46 @@ func (x *CreateLobbyRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLobbyRes.ProtoReflect.Descriptor instead.
-func (*CreateLobbyRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use MatchSettings.ProtoReflect.Descriptor instead.
+func (*MatchSettings) Descriptor() ([]byte, []int) {
 	return file_message_passing_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateLobbyRes) GetLobbyId() string {
-	if x != nil && x.LobbyId != nil {
-		return *x.LobbyId
+func (x *MatchSettings) GetRounds() int32 {
+	if x != nil && x.Rounds != nil {
+		return *x.Rounds
 	}
-	return ""
+	return 0
 }
 
-type LoginRequest struct {
+func (x *MatchSettings) GetIntermission() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Intermission
+	}
+	return nil
+}
+
+func (x *MatchSettings) GetRoundSettings() []*LobbySettings {
+	if x != nil {
+		return x.RoundSettings
+	}
+	return nil
+}
+
+type ProblemFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username *string `protobuf:"bytes,1,req,name=username" json:"username,omitempty"`
-	Password *string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
-	LobbyId  *string `protobuf:"bytes,3,req,name=lobby_id,json=lobbyId" json:"lobby_id,omitempty"`
+	// Bounds on the length of a problem's source; a max_length of 0 means no upper bound
+	MinLength *int32 `protobuf:"varint,1,opt,name=min_length,json=minLength" json:"min_length,omitempty"`
+	MaxLength *int32 `protobuf:"varint,2,opt,name=max_length,json=maxLength" json:"max_length,omitempty"`
+	// Only use problems with this in their title or description
+	Search *string `protobuf:"bytes,3,opt,name=search" json:"search,omitempty"`
 }
 
-func (x *LoginRequest) Reset() {
-	*x = LoginRequest{}
+func (x *ProblemFilter) Reset() {
+	*x = ProblemFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_passing_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,13 +785,13 @@ func (x *LoginRequest) Reset() {
 	}
 }
 
-func (x *LoginRequest) String() string {
+func (x *ProblemFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginRequest) ProtoMessage() {}
+func (*ProblemFilter) ProtoMessage() {}
 
-func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+func (x *ProblemFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_message_passing_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,43 +803,69 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
-func (*LoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProblemFilter.ProtoReflect.Descriptor instead.
+func (*ProblemFilter) Descriptor() ([]byte, []int) {
 	return file_message_passing_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LoginRequest) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+func (x *ProblemFilter) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
 	}
-	return ""
+	return 0
 }
 
-func (x *LoginRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *ProblemFilter) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
 	}
-	return ""
+	return 0
 }
 
-func (x *LoginRequest) GetLobbyId() string {
-	if x != nil && x.LobbyId != nil {
-		return *x.LobbyId
+func (x *ProblemFilter) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
 	}
 	return ""
 }
 
-type LoginResponse struct {
+type LobbySettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Otp     *string `protobuf:"bytes,1,req,name=otp" json:"otp,omitempty"`
-	IsOwner *bool   `protobuf:"varint,2,req,name=is_owner,json=isOwner" json:"is_owner,omitempty"`
-}
-
-func (x *LoginResponse) Reset() {
-	*x = LoginResponse{}
+	// 0 means there's no time limit, which is only allowed with a win condition
+	Duration *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=duration" json:"duration,omitempty"`
+	// 0 means there's no limit
+	MaxPlayers    *int32                 `protobuf:"varint,2,opt,name=max_players,json=maxPlayers" json:"max_players,omitempty"`
+	Visibility    *Visibility            `protobuf:"varint,3,opt,name=visibility,enum=Visibility" json:"visibility,omitempty"`
+	ScoringMode   *ScoringMode           `protobuf:"varint,4,opt,name=scoring_mode,json=scoringMode,enum=ScoringMode" json:"scoring_mode,omitempty"`
+	JudgeMode     *JudgeMode             `protobuf:"varint,5,opt,name=judge_mode,json=judgeMode,enum=JudgeMode" json:"judge_mode,omitempty"`
+	ProblemFilter *ProblemFilter         `protobuf:"bytes,6,opt,name=problem_filter,json=problemFilter" json:"problem_filter,omitempty"`
+	LateJoin      *LateJoinPolicy        `protobuf:"varint,7,opt,name=late_join,json=lateJoin,enum=LateJoinPolicy" json:"late_join,omitempty"`
+	Countdown     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=countdown" json:"countdown,omitempty"`
+	RandomOrder   *bool                  `protobuf:"varint,9,opt,name=random_order,json=randomOrder" json:"random_order,omitempty"`
+	// Teammates share one sequence of problems, so one of them solving a problem moves the team on
+	TeamSharedProblems *bool                `protobuf:"varint,10,opt,name=team_shared_problems,json=teamSharedProblems" json:"team_shared_problems,omitempty"`
+	GameMode           *GameMode            `protobuf:"varint,11,opt,name=game_mode,json=gameMode,enum=GameMode" json:"game_mode,omitempty"`
+	Race               *RaceSettings        `protobuf:"bytes,12,opt,name=race" json:"race,omitempty"`
+	Elimination        *EliminationSettings `protobuf:"bytes,13,opt,name=elimination" json:"elimination,omitempty"`
+	WinCondition       *WinCondition        `protobuf:"bytes,14,opt,name=win_condition,json=winCondition" json:"win_condition,omitempty"`
+	// Stops players chatting while the game's being played
+	ChatDisabledInPlay *bool             `protobuf:"varint,15,opt,name=chat_disabled_in_play,json=chatDisabledInPlay" json:"chat_disabled_in_play,omitempty"`
+	ReadyRequirement   *ReadyRequirement `protobuf:"varint,16,opt,name=ready_requirement,json=readyRequirement,enum=ReadyRequirement" json:"ready_requirement,omitempty"`
+	// Percentage of the (connected, not counting the owner) players that have to be ready for a quorum;
+	// 0 means a majority
+	ReadyQuorum *int32 `protobuf:"varint,17,opt,name=ready_quorum,json=readyQuorum" json:"ready_quorum,omitempty"`
+	// Percentage of the time already played that late joiners get back as extra time
+	LateJoinAllowance *int32 `protobuf:"varint,18,opt,name=late_join_allowance,json=lateJoinAllowance" json:"late_join_allowance,omitempty"`
+	// How long a disconnected player has to reconnect before they count as having left
+	LeaveAfter *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=leave_after,json=leaveAfter" json:"leave_after,omitempty"`
+	Match      *MatchSettings         `protobuf:"bytes,20,opt,name=match" json:"match,omitempty"`
+}
+
+func (x *LobbySettings) Reset() {
+	*x = LobbySettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_message_passing_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,13 +873,13 @@ func (x *LoginResponse) Reset() {
 	}
 }
 
-func (x *LoginResponse) String() string {
+func (x *LobbySettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResponse) ProtoMessage() {}
+func (*LobbySettings) ProtoMessage() {}
 
-func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+func (x *LobbySettings) ProtoReflect() protoreflect.Message {
 	mi := &file_message_passing_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,197 +891,181 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
-func (*LoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LobbySettings.ProtoReflect.Descriptor instead.
+func (*LobbySettings) Descriptor() ([]byte, []int) {
 	return file_message_passing_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LoginResponse) GetOtp() string {
-	if x != nil && x.Otp != nil {
-		return *x.Otp
+func (x *LobbySettings) GetDuration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Duration
 	}
-	return ""
+	return nil
 }
 
-func (x *LoginResponse) GetIsOwner() bool {
-	if x != nil && x.IsOwner != nil {
-		return *x.IsOwner
+func (x *LobbySettings) GetMaxPlayers() int32 {
+	if x != nil && x.MaxPlayers != nil {
+		return *x.MaxPlayers
 	}
-	return false
+	return 0
 }
 
-type ServerSent_RemoveMember struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+func (x *LobbySettings) GetVisibility() Visibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return Visibility_PRIVATE
 }
 
-func (x *ServerSent_RemoveMember) Reset() {
-	*x = ServerSent_RemoveMember{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LobbySettings) GetScoringMode() ScoringMode {
+	if x != nil && x.ScoringMode != nil {
+		return *x.ScoringMode
 	}
+	return ScoringMode_LENGTH
 }
 
-func (x *ServerSent_RemoveMember) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LobbySettings) GetJudgeMode() JudgeMode {
+	if x != nil && x.JudgeMode != nil {
+		return *x.JudgeMode
+	}
+	return JudgeMode_CLIENT
 }
 
-func (*ServerSent_RemoveMember) ProtoMessage() {}
+func (x *LobbySettings) GetProblemFilter() *ProblemFilter {
+	if x != nil {
+		return x.ProblemFilter
+	}
+	return nil
+}
 
-func (x *ServerSent_RemoveMember) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LobbySettings) GetLateJoin() LateJoinPolicy {
+	if x != nil && x.LateJoin != nil {
+		return *x.LateJoin
 	}
-	return mi.MessageOf(x)
+	return LateJoinPolicy_ALLOW_LATE_JOIN
 }
 
-// Deprecated: Use ServerSent_RemoveMember.ProtoReflect.Descriptor instead.
-func (*ServerSent_RemoveMember) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{1, 0}
+func (x *LobbySettings) GetCountdown() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Countdown
+	}
+	return nil
 }
 
-func (x *ServerSent_RemoveMember) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *LobbySettings) GetRandomOrder() bool {
+	if x != nil && x.RandomOrder != nil {
+		return *x.RandomOrder
 	}
-	return ""
+	return false
 }
 
-type ServerSent_AddMember struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-}
-
-func (x *ServerSent_AddMember) Reset() {
-	*x = ServerSent_AddMember{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LobbySettings) GetTeamSharedProblems() bool {
+	if x != nil && x.TeamSharedProblems != nil {
+		return *x.TeamSharedProblems
 	}
+	return false
 }
 
-func (x *ServerSent_AddMember) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ServerSent_AddMember) ProtoMessage() {}
-
-func (x *ServerSent_AddMember) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LobbySettings) GetGameMode() GameMode {
+	if x != nil && x.GameMode != nil {
+		return *x.GameMode
 	}
-	return mi.MessageOf(x)
+	return GameMode_STANDARD
 }
 
-// Deprecated: Use ServerSent_AddMember.ProtoReflect.Descriptor instead.
-func (*ServerSent_AddMember) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{1, 1}
+func (x *LobbySettings) GetRace() *RaceSettings {
+	if x != nil {
+		return x.Race
+	}
+	return nil
 }
 
-func (x *ServerSent_AddMember) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *LobbySettings) GetElimination() *EliminationSettings {
+	if x != nil {
+		return x.Elimination
 	}
-	return ""
+	return nil
 }
 
-type ServerSent_StartGame struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,req,name=startTime" json:"startTime,omitempty"`
-	Duration  *timestamppb.Timestamp `protobuf:"bytes,2,req,name=duration" json:"duration,omitempty"`
+func (x *LobbySettings) GetWinCondition() *WinCondition {
+	if x != nil {
+		return x.WinCondition
+	}
+	return nil
 }
 
-func (x *ServerSent_StartGame) Reset() {
-	*x = ServerSent_StartGame{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LobbySettings) GetChatDisabledInPlay() bool {
+	if x != nil && x.ChatDisabledInPlay != nil {
+		return *x.ChatDisabledInPlay
 	}
+	return false
 }
 
-func (x *ServerSent_StartGame) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LobbySettings) GetReadyRequirement() ReadyRequirement {
+	if x != nil && x.ReadyRequirement != nil {
+		return *x.ReadyRequirement
+	}
+	return ReadyRequirement_NO_READY_CHECK
 }
 
-func (*ServerSent_StartGame) ProtoMessage() {}
-
-func (x *ServerSent_StartGame) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LobbySettings) GetReadyQuorum() int32 {
+	if x != nil && x.ReadyQuorum != nil {
+		return *x.ReadyQuorum
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ServerSent_StartGame.ProtoReflect.Descriptor instead.
-func (*ServerSent_StartGame) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{1, 2}
+func (x *LobbySettings) GetLateJoinAllowance() int32 {
+	if x != nil && x.LateJoinAllowance != nil {
+		return *x.LateJoinAllowance
+	}
+	return 0
 }
 
-func (x *ServerSent_StartGame) GetStartTime() *timestamppb.Timestamp {
+func (x *LobbySettings) GetLeaveAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartTime
+		return x.LeaveAfter
 	}
 	return nil
 }
 
-func (x *ServerSent_StartGame) GetDuration() *timestamppb.Timestamp {
+func (x *LobbySettings) GetMatch() *MatchSettings {
 	if x != nil {
-		return x.Duration
+		return x.Match
 	}
 	return nil
 }
 
-type ServerSent_EndGame struct {
+type Handicap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Extra time the player gets on top of the game's duration
+	ExtraTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=extra_time,json=extraTime" json:"extra_time,omitempty"`
+	// The points for each of the player's solves are multiplied by this (1 if it isn't set)
+	ScoreMultiplier *float32 `protobuf:"fixed32,2,opt,name=score_multiplier,json=scoreMultiplier" json:"score_multiplier,omitempty"`
+	// Points the player starts the game with
+	HeadStart *int32 `protobuf:"varint,3,opt,name=head_start,json=headStart" json:"head_start,omitempty"`
 }
 
-func (x *ServerSent_EndGame) Reset() {
-	*x = ServerSent_EndGame{}
+func (x *Handicap) Reset() {
+	*x = Handicap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[10]
+		mi := &file_message_passing_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerSent_EndGame) String() string {
+func (x *Handicap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerSent_EndGame) ProtoMessage() {}
+func (*Handicap) ProtoMessage() {}
 
-func (x *ServerSent_EndGame) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[10]
+func (x *Handicap) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,36 +1076,62 @@ func (x *ServerSent_EndGame) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerSent_EndGame.ProtoReflect.Descriptor instead.
-func (*ServerSent_EndGame) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{1, 3}
+// Deprecated: Use Handicap.ProtoReflect.Descriptor instead.
+func (*Handicap) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{7}
 }
 
-type ServerSent_NewProblem struct {
+func (x *Handicap) GetExtraTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtraTime
+	}
+	return nil
+}
+
+func (x *Handicap) GetScoreMultiplier() float32 {
+	if x != nil && x.ScoreMultiplier != nil {
+		return *x.ScoreMultiplier
+	}
+	return 0
+}
+
+func (x *Handicap) GetHeadStart() int32 {
+	if x != nil && x.HeadStart != nil {
+		return *x.HeadStart
+	}
+	return 0
+}
+
+// What the member list shows about a member, besides their name
+type Member struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Problem *Problem `protobuf:"bytes,1,req,name=problem" json:"problem,omitempty"`
+	Name     *string   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Handicap *Handicap `protobuf:"bytes,2,opt,name=handicap" json:"handicap,omitempty"`
+	Ready    *bool     `protobuf:"varint,3,opt,name=ready" json:"ready,omitempty"`
+	Score    *int32    `protobuf:"varint,4,opt,name=score" json:"score,omitempty"`
+	Presence *Presence `protobuf:"varint,5,opt,name=presence,enum=Presence" json:"presence,omitempty"`
 }
 
-func (x *ServerSent_NewProblem) Reset() {
-	*x = ServerSent_NewProblem{}
+func (x *Member) Reset() {
+	*x = Member{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[11]
+		mi := &file_message_passing_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerSent_NewProblem) String() string {
+func (x *Member) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerSent_NewProblem) ProtoMessage() {}
+func (*Member) ProtoMessage() {}
 
-func (x *ServerSent_NewProblem) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[11]
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,19 +1142,47 @@ func (x *ServerSent_NewProblem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerSent_NewProblem.ProtoReflect.Descriptor instead.
-func (*ServerSent_NewProblem) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{1, 4}
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ServerSent_NewProblem) GetProblem() *Problem {
+func (x *Member) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Member) GetHandicap() *Handicap {
 	if x != nil {
-		return x.Problem
+		return x.Handicap
 	}
 	return nil
 }
 
-type ServerSent_ScoreUpdate struct {
+func (x *Member) GetReady() bool {
+	if x != nil && x.Ready != nil {
+		return *x.Ready
+	}
+	return false
+}
+
+func (x *Member) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *Member) GetPresence() Presence {
+	if x != nil && x.Presence != nil {
+		return *x.Presence
+	}
+	return Presence_CONNECTED
+}
+
+type Standing struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -785,23 +1191,23 @@ type ServerSent_ScoreUpdate struct {
 	Score *int32  `protobuf:"varint,2,req,name=score" json:"score,omitempty"`
 }
 
-func (x *ServerSent_ScoreUpdate) Reset() {
-	*x = ServerSent_ScoreUpdate{}
+func (x *Standing) Reset() {
+	*x = Standing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[12]
+		mi := &file_message_passing_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerSent_ScoreUpdate) String() string {
+func (x *Standing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerSent_ScoreUpdate) ProtoMessage() {}
+func (*Standing) ProtoMessage() {}
 
-func (x *ServerSent_ScoreUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[12]
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,48 +1218,51 @@ func (x *ServerSent_ScoreUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerSent_ScoreUpdate.ProtoReflect.Descriptor instead.
-func (*ServerSent_ScoreUpdate) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{1, 5}
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ServerSent_ScoreUpdate) GetName() string {
+func (x *Standing) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *ServerSent_ScoreUpdate) GetScore() int32 {
+func (x *Standing) GetScore() int32 {
 	if x != nil && x.Score != nil {
 		return *x.Score
 	}
 	return 0
 }
 
-type ServerSent_WrongAnswer struct {
+type Team struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name    *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
 }
 
-func (x *ServerSent_WrongAnswer) Reset() {
-	*x = ServerSent_WrongAnswer{}
+func (x *Team) Reset() {
+	*x = Team{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[13]
+		mi := &file_message_passing_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerSent_WrongAnswer) String() string {
+func (x *Team) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerSent_WrongAnswer) ProtoMessage() {}
+func (*Team) ProtoMessage() {}
 
-func (x *ServerSent_WrongAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[13]
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,38 +1273,80 @@ func (x *ServerSent_WrongAnswer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerSent_WrongAnswer.ProtoReflect.Descriptor instead.
-func (*ServerSent_WrongAnswer) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{1, 6}
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{10}
 }
 
-type ClientSent_RequestStart struct {
+func (x *Team) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ServerSent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Duration *timestamppb.Timestamp `protobuf:"bytes,1,req,name=duration" json:"duration,omitempty"`
-	IsRandom *bool                  `protobuf:"varint,2,req,name=is_random,json=isRandom" json:"is_random,omitempty"`
-	Problems []*Problem             `protobuf:"bytes,3,rep,name=problems" json:"problems,omitempty"`
+	// Counts up through the messages sent in a session, which are replayed if it's resumed
+	Seq *uint64 `protobuf:"varint,25,opt,name=seq" json:"seq,omitempty"`
+	// Types that are assignable to Message:
+	//
+	//	*ServerSent_Remove
+	//	*ServerSent_Add
+	//	*ServerSent_Start
+	//	*ServerSent_NewProblem_
+	//	*ServerSent_End
+	//	*ServerSent_ScoreUpdate_
+	//	*ServerSent_Wrong
+	//	*ServerSent_Rematch_
+	//	*ServerSent_Countdown_
+	//	*ServerSent_Paused_
+	//	*ServerSent_Resumed_
+	//	*ServerSent_OwnerChanged_
+	//	*ServerSent_Settings_
+	//	*ServerSent_Error_
+	//	*ServerSent_FollowedProblem_
+	//	*ServerSent_Teams_
+	//	*ServerSent_RoundStart_
+	//	*ServerSent_RoundEnd_
+	//	*ServerSent_Eliminated_
+	//	*ServerSent_MemberUpdate_
+	//	*ServerSent_Progress_
+	//	*ServerSent_Chat_
+	//	*ServerSent_Scheduled_
+	//	*ServerSent_Session_
+	//	*ServerSent_Snapshot
+	//	*ServerSent_MatchRoundEnd_
+	Message isServerSent_Message `protobuf_oneof:"message"`
 }
 
-func (x *ClientSent_RequestStart) Reset() {
-	*x = ClientSent_RequestStart{}
+func (x *ServerSent) Reset() {
+	*x = ServerSent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[14]
+		mi := &file_message_passing_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientSent_RequestStart) String() string {
+func (x *ServerSent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientSent_RequestStart) ProtoMessage() {}
+func (*ServerSent) ProtoMessage() {}
 
-func (x *ClientSent_RequestStart) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[14]
+func (x *ServerSent) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,102 +1357,415 @@ func (x *ClientSent_RequestStart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientSent_RequestStart.ProtoReflect.Descriptor instead.
-func (*ClientSent_RequestStart) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use ServerSent.ProtoReflect.Descriptor instead.
+func (*ServerSent) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ClientSent_RequestStart) GetDuration() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Duration
+func (x *ServerSent) GetSeq() uint64 {
+	if x != nil && x.Seq != nil {
+		return *x.Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *ClientSent_RequestStart) GetIsRandom() bool {
-	if x != nil && x.IsRandom != nil {
-		return *x.IsRandom
+func (m *ServerSent) GetMessage() isServerSent_Message {
+	if m != nil {
+		return m.Message
 	}
-	return false
+	return nil
 }
 
-func (x *ClientSent_RequestStart) GetProblems() []*Problem {
-	if x != nil {
-		return x.Problems
+func (x *ServerSent) GetRemove() *ServerSent_RemoveMember {
+	if x, ok := x.GetMessage().(*ServerSent_Remove); ok {
+		return x.Remove
 	}
 	return nil
 }
 
-type ClientSent_GiveAnswer struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Answer *string `protobuf:"bytes,1,req,name=answer" json:"answer,omitempty"`
+func (x *ServerSent) GetAdd() *ServerSent_AddMember {
+	if x, ok := x.GetMessage().(*ServerSent_Add); ok {
+		return x.Add
+	}
+	return nil
 }
 
-func (x *ClientSent_GiveAnswer) Reset() {
-	*x = ClientSent_GiveAnswer{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ServerSent) GetStart() *ServerSent_StartGame {
+	if x, ok := x.GetMessage().(*ServerSent_Start); ok {
+		return x.Start
 	}
+	return nil
 }
 
-func (x *ClientSent_GiveAnswer) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ServerSent) GetNewProblem() *ServerSent_NewProblem {
+	if x, ok := x.GetMessage().(*ServerSent_NewProblem_); ok {
+		return x.NewProblem
+	}
+	return nil
 }
 
-func (*ClientSent_GiveAnswer) ProtoMessage() {}
+func (x *ServerSent) GetEnd() *ServerSent_EndGame {
+	if x, ok := x.GetMessage().(*ServerSent_End); ok {
+		return x.End
+	}
+	return nil
+}
 
-func (x *ClientSent_GiveAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ServerSent) GetScoreUpdate() *ServerSent_ScoreUpdate {
+	if x, ok := x.GetMessage().(*ServerSent_ScoreUpdate_); ok {
+		return x.ScoreUpdate
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ClientSent_GiveAnswer.ProtoReflect.Descriptor instead.
-func (*ClientSent_GiveAnswer) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{2, 1}
+func (x *ServerSent) GetWrong() *ServerSent_WrongAnswer {
+	if x, ok := x.GetMessage().(*ServerSent_Wrong); ok {
+		return x.Wrong
+	}
+	return nil
 }
 
-func (x *ClientSent_GiveAnswer) GetAnswer() string {
-	if x != nil && x.Answer != nil {
-		return *x.Answer
+func (x *ServerSent) GetRematch() *ServerSent_Rematch {
+	if x, ok := x.GetMessage().(*ServerSent_Rematch_); ok {
+		return x.Rematch
 	}
-	return ""
+	return nil
 }
 
-type ClientSent_RequestProblem struct {
+func (x *ServerSent) GetCountdown() *ServerSent_Countdown {
+	if x, ok := x.GetMessage().(*ServerSent_Countdown_); ok {
+		return x.Countdown
+	}
+	return nil
+}
+
+func (x *ServerSent) GetPaused() *ServerSent_Paused {
+	if x, ok := x.GetMessage().(*ServerSent_Paused_); ok {
+		return x.Paused
+	}
+	return nil
+}
+
+func (x *ServerSent) GetResumed() *ServerSent_Resumed {
+	if x, ok := x.GetMessage().(*ServerSent_Resumed_); ok {
+		return x.Resumed
+	}
+	return nil
+}
+
+func (x *ServerSent) GetOwnerChanged() *ServerSent_OwnerChanged {
+	if x, ok := x.GetMessage().(*ServerSent_OwnerChanged_); ok {
+		return x.OwnerChanged
+	}
+	return nil
+}
+
+func (x *ServerSent) GetSettings() *ServerSent_Settings {
+	if x, ok := x.GetMessage().(*ServerSent_Settings_); ok {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ServerSent) GetError() *ServerSent_Error {
+	if x, ok := x.GetMessage().(*ServerSent_Error_); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ServerSent) GetFollowedProblem() *ServerSent_FollowedProblem {
+	if x, ok := x.GetMessage().(*ServerSent_FollowedProblem_); ok {
+		return x.FollowedProblem
+	}
+	return nil
+}
+
+func (x *ServerSent) GetTeams() *ServerSent_Teams {
+	if x, ok := x.GetMessage().(*ServerSent_Teams_); ok {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *ServerSent) GetRoundStart() *ServerSent_RoundStart {
+	if x, ok := x.GetMessage().(*ServerSent_RoundStart_); ok {
+		return x.RoundStart
+	}
+	return nil
+}
+
+func (x *ServerSent) GetRoundEnd() *ServerSent_RoundEnd {
+	if x, ok := x.GetMessage().(*ServerSent_RoundEnd_); ok {
+		return x.RoundEnd
+	}
+	return nil
+}
+
+func (x *ServerSent) GetEliminated() *ServerSent_Eliminated {
+	if x, ok := x.GetMessage().(*ServerSent_Eliminated_); ok {
+		return x.Eliminated
+	}
+	return nil
+}
+
+func (x *ServerSent) GetMemberUpdate() *ServerSent_MemberUpdate {
+	if x, ok := x.GetMessage().(*ServerSent_MemberUpdate_); ok {
+		return x.MemberUpdate
+	}
+	return nil
+}
+
+func (x *ServerSent) GetProgress() *ServerSent_Progress {
+	if x, ok := x.GetMessage().(*ServerSent_Progress_); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *ServerSent) GetChat() *ServerSent_Chat {
+	if x, ok := x.GetMessage().(*ServerSent_Chat_); ok {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *ServerSent) GetScheduled() *ServerSent_Scheduled {
+	if x, ok := x.GetMessage().(*ServerSent_Scheduled_); ok {
+		return x.Scheduled
+	}
+	return nil
+}
+
+func (x *ServerSent) GetSession() *ServerSent_Session {
+	if x, ok := x.GetMessage().(*ServerSent_Session_); ok {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *ServerSent) GetSnapshot() *ServerSent_LobbySnapshot {
+	if x, ok := x.GetMessage().(*ServerSent_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *ServerSent) GetMatchRoundEnd() *ServerSent_MatchRoundEnd {
+	if x, ok := x.GetMessage().(*ServerSent_MatchRoundEnd_); ok {
+		return x.MatchRoundEnd
+	}
+	return nil
+}
+
+type isServerSent_Message interface {
+	isServerSent_Message()
+}
+
+type ServerSent_Remove struct {
+	Remove *ServerSent_RemoveMember `protobuf:"bytes,1,opt,name=remove,oneof"`
+}
+
+type ServerSent_Add struct {
+	Add *ServerSent_AddMember `protobuf:"bytes,2,opt,name=add,oneof"`
+}
+
+type ServerSent_Start struct {
+	Start *ServerSent_StartGame `protobuf:"bytes,3,opt,name=start,oneof"`
+}
+
+type ServerSent_NewProblem_ struct {
+	NewProblem *ServerSent_NewProblem `protobuf:"bytes,4,opt,name=new_problem,json=newProblem,oneof"`
+}
+
+type ServerSent_End struct {
+	End *ServerSent_EndGame `protobuf:"bytes,5,opt,name=end,oneof"`
+}
+
+type ServerSent_ScoreUpdate_ struct {
+	ScoreUpdate *ServerSent_ScoreUpdate `protobuf:"bytes,6,opt,name=score_update,json=scoreUpdate,oneof"`
+}
+
+type ServerSent_Wrong struct {
+	Wrong *ServerSent_WrongAnswer `protobuf:"bytes,7,opt,name=wrong,oneof"`
+}
+
+type ServerSent_Rematch_ struct {
+	Rematch *ServerSent_Rematch `protobuf:"bytes,8,opt,name=rematch,oneof"`
+}
+
+type ServerSent_Countdown_ struct {
+	Countdown *ServerSent_Countdown `protobuf:"bytes,9,opt,name=countdown,oneof"`
+}
+
+type ServerSent_Paused_ struct {
+	Paused *ServerSent_Paused `protobuf:"bytes,10,opt,name=paused,oneof"`
+}
+
+type ServerSent_Resumed_ struct {
+	Resumed *ServerSent_Resumed `protobuf:"bytes,11,opt,name=resumed,oneof"`
+}
+
+type ServerSent_OwnerChanged_ struct {
+	OwnerChanged *ServerSent_OwnerChanged `protobuf:"bytes,12,opt,name=owner_changed,json=ownerChanged,oneof"`
+}
+
+type ServerSent_Settings_ struct {
+	Settings *ServerSent_Settings `protobuf:"bytes,13,opt,name=settings,oneof"`
+}
+
+type ServerSent_Error_ struct {
+	Error *ServerSent_Error `protobuf:"bytes,14,opt,name=error,oneof"`
+}
+
+type ServerSent_FollowedProblem_ struct {
+	FollowedProblem *ServerSent_FollowedProblem `protobuf:"bytes,15,opt,name=followed_problem,json=followedProblem,oneof"`
+}
+
+type ServerSent_Teams_ struct {
+	Teams *ServerSent_Teams `protobuf:"bytes,16,opt,name=teams,oneof"`
+}
+
+type ServerSent_RoundStart_ struct {
+	RoundStart *ServerSent_RoundStart `protobuf:"bytes,17,opt,name=round_start,json=roundStart,oneof"`
+}
+
+type ServerSent_RoundEnd_ struct {
+	RoundEnd *ServerSent_RoundEnd `protobuf:"bytes,18,opt,name=round_end,json=roundEnd,oneof"`
+}
+
+type ServerSent_Eliminated_ struct {
+	Eliminated *ServerSent_Eliminated `protobuf:"bytes,19,opt,name=eliminated,oneof"`
+}
+
+type ServerSent_MemberUpdate_ struct {
+	MemberUpdate *ServerSent_MemberUpdate `protobuf:"bytes,20,opt,name=member_update,json=memberUpdate,oneof"`
+}
+
+type ServerSent_Progress_ struct {
+	Progress *ServerSent_Progress `protobuf:"bytes,21,opt,name=progress,oneof"`
+}
+
+type ServerSent_Chat_ struct {
+	Chat *ServerSent_Chat `protobuf:"bytes,22,opt,name=chat,oneof"`
+}
+
+type ServerSent_Scheduled_ struct {
+	Scheduled *ServerSent_Scheduled `protobuf:"bytes,23,opt,name=scheduled,oneof"`
+}
+
+type ServerSent_Session_ struct {
+	Session *ServerSent_Session `protobuf:"bytes,24,opt,name=session,oneof"`
+}
+
+type ServerSent_Snapshot struct {
+	Snapshot *ServerSent_LobbySnapshot `protobuf:"bytes,26,opt,name=snapshot,oneof"`
+}
+
+type ServerSent_MatchRoundEnd_ struct {
+	MatchRoundEnd *ServerSent_MatchRoundEnd `protobuf:"bytes,27,opt,name=match_round_end,json=matchRoundEnd,oneof"`
+}
+
+func (*ServerSent_Remove) isServerSent_Message() {}
+
+func (*ServerSent_Add) isServerSent_Message() {}
+
+func (*ServerSent_Start) isServerSent_Message() {}
+
+func (*ServerSent_NewProblem_) isServerSent_Message() {}
+
+func (*ServerSent_End) isServerSent_Message() {}
+
+func (*ServerSent_ScoreUpdate_) isServerSent_Message() {}
+
+func (*ServerSent_Wrong) isServerSent_Message() {}
+
+func (*ServerSent_Rematch_) isServerSent_Message() {}
+
+func (*ServerSent_Countdown_) isServerSent_Message() {}
+
+func (*ServerSent_Paused_) isServerSent_Message() {}
+
+func (*ServerSent_Resumed_) isServerSent_Message() {}
+
+func (*ServerSent_OwnerChanged_) isServerSent_Message() {}
+
+func (*ServerSent_Settings_) isServerSent_Message() {}
+
+func (*ServerSent_Error_) isServerSent_Message() {}
+
+func (*ServerSent_FollowedProblem_) isServerSent_Message() {}
+
+func (*ServerSent_Teams_) isServerSent_Message() {}
+
+func (*ServerSent_RoundStart_) isServerSent_Message() {}
+
+func (*ServerSent_RoundEnd_) isServerSent_Message() {}
+
+func (*ServerSent_Eliminated_) isServerSent_Message() {}
+
+func (*ServerSent_MemberUpdate_) isServerSent_Message() {}
+
+func (*ServerSent_Progress_) isServerSent_Message() {}
+
+func (*ServerSent_Chat_) isServerSent_Message() {}
+
+func (*ServerSent_Scheduled_) isServerSent_Message() {}
+
+func (*ServerSent_Session_) isServerSent_Message() {}
+
+func (*ServerSent_Snapshot) isServerSent_Message() {}
+
+func (*ServerSent_MatchRoundEnd_) isServerSent_Message() {}
+
+type ClientSent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*ClientSent_RequestStart_
+	//	*ClientSent_Answer
+	//	*ClientSent_RequestProblem_
+	//	*ClientSent_RequestRematch_
+	//	*ClientSent_Pause
+	//	*ClientSent_Resume
+	//	*ClientSent_RequestEnd_
+	//	*ClientSent_TransferOwnership_
+	//	*ClientSent_Kick
+	//	*ClientSent_Ban
+	//	*ClientSent_UpdateSettings_
+	//	*ClientSent_Follow_
+	//	*ClientSent_DefineTeams_
+	//	*ClientSent_JoinTeam_
+	//	*ClientSent_AssignTeam_
+	//	*ClientSent_SetHandicap_
+	//	*ClientSent_Draft_
+	//	*ClientSent_Chat_
+	//	*ClientSent_SetReady_
+	//	*ClientSent_ScheduleStart_
+	//	*ClientSent_SetChatDisabled_
+	Message isClientSent_Message `protobuf_oneof:"message"`
 }
 
-func (x *ClientSent_RequestProblem) Reset() {
-	*x = ClientSent_RequestProblem{}
+func (x *ClientSent) Reset() {
+	*x = ClientSent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_passing_proto_msgTypes[16]
+		mi := &file_message_passing_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientSent_RequestProblem) String() string {
+func (x *ClientSent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientSent_RequestProblem) ProtoMessage() {}
+func (*ClientSent) ProtoMessage() {}
 
-func (x *ClientSent_RequestProblem) ProtoReflect() protoreflect.Message {
-	mi := &file_message_passing_proto_msgTypes[16]
+func (x *ClientSent) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,176 +1776,4180 @@ func (x *ClientSent_RequestProblem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientSent_RequestProblem.ProtoReflect.Descriptor instead.
-func (*ClientSent_RequestProblem) Descriptor() ([]byte, []int) {
-	return file_message_passing_proto_rawDescGZIP(), []int{2, 2}
+// Deprecated: Use ClientSent.ProtoReflect.Descriptor instead.
+func (*ClientSent) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12}
 }
 
-var File_message_passing_proto protoreflect.FileDescriptor
+func (m *ClientSent) GetMessage() isClientSent_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
 
-var file_message_passing_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x62,
-	0x6c, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x74, 0x65, 0x78, 0x18, 0x01, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x74, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x22, 0xc1, 0x05, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74,
-	0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x41,
-	0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12,
-	0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x39,
-	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74,
-	0x2e, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x6e,
-	0x65, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x03, 0x65, 0x6e, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
-	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x03, 0x65,
-	0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x2f, 0x0a, 0x05, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x72, 0x6f,
-	0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x05, 0x77, 0x72, 0x6f, 0x6e,
-	0x67, 0x1a, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1f, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x7d, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47,
-	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a,
-	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x09, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65,
-	0x1a, 0x30, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x22,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32,
-	0x08, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c,
-	0x65, 0x6d, 0x1a, 0x37, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x0d, 0x0a, 0x0b, 0x57,
-	0x72, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x95, 0x03, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x53, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x6e, 0x74, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52,
-	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x1a, 0x89,
-	0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
-	0x36, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x72, 0x61,
-	0x6e, 0x64, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x02, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x52, 0x61,
-	0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x1a, 0x24, 0x0a, 0x0a, 0x47, 0x69,
-	0x76, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
-	0x1a, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c,
-	0x65, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2f, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b,
-	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73,
-	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x0c, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x22, 0x3c,
-	0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x6f, 0x74, 0x70, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x74,
-	0x70, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x02, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x07, 0x5a, 0x05,
-	0x6d, 0x61, 0x69, 0x6e, 0x2f,
+func (x *ClientSent) GetRequestStart() *ClientSent_RequestStart {
+	if x, ok := x.GetMessage().(*ClientSent_RequestStart_); ok {
+		return x.RequestStart
+	}
+	return nil
 }
 
-var (
-	file_message_passing_proto_rawDescOnce sync.Once
-	file_message_passing_proto_rawDescData = file_message_passing_proto_rawDesc
-)
+func (x *ClientSent) GetAnswer() *ClientSent_GiveAnswer {
+	if x, ok := x.GetMessage().(*ClientSent_Answer); ok {
+		return x.Answer
+	}
+	return nil
+}
 
-func file_message_passing_proto_rawDescGZIP() []byte {
-	file_message_passing_proto_rawDescOnce.Do(func() {
-		file_message_passing_proto_rawDescData = protoimpl.X.CompressGZIP(file_message_passing_proto_rawDescData)
-	})
-	return file_message_passing_proto_rawDescData
+func (x *ClientSent) GetRequestProblem() *ClientSent_RequestProblem {
+	if x, ok := x.GetMessage().(*ClientSent_RequestProblem_); ok {
+		return x.RequestProblem
+	}
+	return nil
 }
 
-var file_message_passing_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_message_passing_proto_goTypes = []interface{}{
-	(*Problem)(nil),                   // 0: Problem
-	(*ServerSent)(nil),                // 1: ServerSent
-	(*ClientSent)(nil),                // 2: ClientSent
-	(*CreateLobbyReq)(nil),            // 3: CreateLobbyReq
-	(*CreateLobbyRes)(nil),            // 4: CreateLobbyRes
-	(*LoginRequest)(nil),              // 5: LoginRequest
-	(*LoginResponse)(nil),             // 6: LoginResponse
-	(*ServerSent_RemoveMember)(nil),   // 7: ServerSent.RemoveMember
-	(*ServerSent_AddMember)(nil),      // 8: ServerSent.AddMember
-	(*ServerSent_StartGame)(nil),      // 9: ServerSent.StartGame
-	(*ServerSent_EndGame)(nil),        // 10: ServerSent.EndGame
-	(*ServerSent_NewProblem)(nil),     // 11: ServerSent.NewProblem
-	(*ServerSent_ScoreUpdate)(nil),    // 12: ServerSent.ScoreUpdate
-	(*ServerSent_WrongAnswer)(nil),    // 13: ServerSent.WrongAnswer
-	(*ClientSent_RequestStart)(nil),   // 14: ClientSent.RequestStart
-	(*ClientSent_GiveAnswer)(nil),     // 15: ClientSent.GiveAnswer
-	(*ClientSent_RequestProblem)(nil), // 16: ClientSent.RequestProblem
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
+func (x *ClientSent) GetRequestRematch() *ClientSent_RequestRematch {
+	if x, ok := x.GetMessage().(*ClientSent_RequestRematch_); ok {
+		return x.RequestRematch
+	}
+	return nil
 }
-var file_message_passing_proto_depIdxs = []int32{
-	7,  // 0: ServerSent.remove:type_name -> ServerSent.RemoveMember
-	8,  // 1: ServerSent.add:type_name -> ServerSent.AddMember
-	9,  // 2: ServerSent.start:type_name -> ServerSent.StartGame
-	11, // 3: ServerSent.new_problem:type_name -> ServerSent.NewProblem
-	10, // 4: ServerSent.end:type_name -> ServerSent.EndGame
-	12, // 5: ServerSent.score_update:type_name -> ServerSent.ScoreUpdate
-	13, // 6: ServerSent.wrong:type_name -> ServerSent.WrongAnswer
-	14, // 7: ClientSent.request_start:type_name -> ClientSent.RequestStart
-	15, // 8: ClientSent.answer:type_name -> ClientSent.GiveAnswer
-	16, // 9: ClientSent.request_problem:type_name -> ClientSent.RequestProblem
-	17, // 10: ServerSent.StartGame.startTime:type_name -> google.protobuf.Timestamp
-	17, // 11: ServerSent.StartGame.duration:type_name -> google.protobuf.Timestamp
-	0,  // 12: ServerSent.NewProblem.problem:type_name -> Problem
-	17, // 13: ClientSent.RequestStart.duration:type_name -> google.protobuf.Timestamp
-	0,  // 14: ClientSent.RequestStart.problems:type_name -> Problem
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+
+func (x *ClientSent) GetPause() *ClientSent_PauseGame {
+	if x, ok := x.GetMessage().(*ClientSent_Pause); ok {
+		return x.Pause
+	}
+	return nil
 }
 
-func init() { file_message_passing_proto_init() }
-func file_message_passing_proto_init() {
-	if File_message_passing_proto != nil {
-		return
+func (x *ClientSent) GetResume() *ClientSent_ResumeGame {
+	if x, ok := x.GetMessage().(*ClientSent_Resume); ok {
+		return x.Resume
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_message_passing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Problem); i {
+	return nil
+}
+
+func (x *ClientSent) GetRequestEnd() *ClientSent_RequestEnd {
+	if x, ok := x.GetMessage().(*ClientSent_RequestEnd_); ok {
+		return x.RequestEnd
+	}
+	return nil
+}
+
+func (x *ClientSent) GetTransferOwnership() *ClientSent_TransferOwnership {
+	if x, ok := x.GetMessage().(*ClientSent_TransferOwnership_); ok {
+		return x.TransferOwnership
+	}
+	return nil
+}
+
+func (x *ClientSent) GetKick() *ClientSent_KickPlayer {
+	if x, ok := x.GetMessage().(*ClientSent_Kick); ok {
+		return x.Kick
+	}
+	return nil
+}
+
+func (x *ClientSent) GetBan() *ClientSent_BanPlayer {
+	if x, ok := x.GetMessage().(*ClientSent_Ban); ok {
+		return x.Ban
+	}
+	return nil
+}
+
+func (x *ClientSent) GetUpdateSettings() *ClientSent_UpdateSettings {
+	if x, ok := x.GetMessage().(*ClientSent_UpdateSettings_); ok {
+		return x.UpdateSettings
+	}
+	return nil
+}
+
+func (x *ClientSent) GetFollow() *ClientSent_Follow {
+	if x, ok := x.GetMessage().(*ClientSent_Follow_); ok {
+		return x.Follow
+	}
+	return nil
+}
+
+func (x *ClientSent) GetDefineTeams() *ClientSent_DefineTeams {
+	if x, ok := x.GetMessage().(*ClientSent_DefineTeams_); ok {
+		return x.DefineTeams
+	}
+	return nil
+}
+
+func (x *ClientSent) GetJoinTeam() *ClientSent_JoinTeam {
+	if x, ok := x.GetMessage().(*ClientSent_JoinTeam_); ok {
+		return x.JoinTeam
+	}
+	return nil
+}
+
+func (x *ClientSent) GetAssignTeam() *ClientSent_AssignTeam {
+	if x, ok := x.GetMessage().(*ClientSent_AssignTeam_); ok {
+		return x.AssignTeam
+	}
+	return nil
+}
+
+func (x *ClientSent) GetSetHandicap() *ClientSent_SetHandicap {
+	if x, ok := x.GetMessage().(*ClientSent_SetHandicap_); ok {
+		return x.SetHandicap
+	}
+	return nil
+}
+
+func (x *ClientSent) GetDraft() *ClientSent_Draft {
+	if x, ok := x.GetMessage().(*ClientSent_Draft_); ok {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *ClientSent) GetChat() *ClientSent_Chat {
+	if x, ok := x.GetMessage().(*ClientSent_Chat_); ok {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *ClientSent) GetSetReady() *ClientSent_SetReady {
+	if x, ok := x.GetMessage().(*ClientSent_SetReady_); ok {
+		return x.SetReady
+	}
+	return nil
+}
+
+func (x *ClientSent) GetScheduleStart() *ClientSent_ScheduleStart {
+	if x, ok := x.GetMessage().(*ClientSent_ScheduleStart_); ok {
+		return x.ScheduleStart
+	}
+	return nil
+}
+
+func (x *ClientSent) GetSetChatDisabled() *ClientSent_SetChatDisabled {
+	if x, ok := x.GetMessage().(*ClientSent_SetChatDisabled_); ok {
+		return x.SetChatDisabled
+	}
+	return nil
+}
+
+type isClientSent_Message interface {
+	isClientSent_Message()
+}
+
+type ClientSent_RequestStart_ struct {
+	RequestStart *ClientSent_RequestStart `protobuf:"bytes,1,opt,name=request_start,json=requestStart,oneof"`
+}
+
+type ClientSent_Answer struct {
+	Answer *ClientSent_GiveAnswer `protobuf:"bytes,2,opt,name=answer,oneof"`
+}
+
+type ClientSent_RequestProblem_ struct {
+	RequestProblem *ClientSent_RequestProblem `protobuf:"bytes,3,opt,name=request_problem,json=requestProblem,oneof"`
+}
+
+type ClientSent_RequestRematch_ struct {
+	RequestRematch *ClientSent_RequestRematch `protobuf:"bytes,4,opt,name=request_rematch,json=requestRematch,oneof"`
+}
+
+type ClientSent_Pause struct {
+	Pause *ClientSent_PauseGame `protobuf:"bytes,5,opt,name=pause,oneof"`
+}
+
+type ClientSent_Resume struct {
+	Resume *ClientSent_ResumeGame `protobuf:"bytes,6,opt,name=resume,oneof"`
+}
+
+type ClientSent_RequestEnd_ struct {
+	RequestEnd *ClientSent_RequestEnd `protobuf:"bytes,7,opt,name=request_end,json=requestEnd,oneof"`
+}
+
+type ClientSent_TransferOwnership_ struct {
+	TransferOwnership *ClientSent_TransferOwnership `protobuf:"bytes,8,opt,name=transfer_ownership,json=transferOwnership,oneof"`
+}
+
+type ClientSent_Kick struct {
+	Kick *ClientSent_KickPlayer `protobuf:"bytes,9,opt,name=kick,oneof"`
+}
+
+type ClientSent_Ban struct {
+	Ban *ClientSent_BanPlayer `protobuf:"bytes,10,opt,name=ban,oneof"`
+}
+
+type ClientSent_UpdateSettings_ struct {
+	UpdateSettings *ClientSent_UpdateSettings `protobuf:"bytes,11,opt,name=update_settings,json=updateSettings,oneof"`
+}
+
+type ClientSent_Follow_ struct {
+	Follow *ClientSent_Follow `protobuf:"bytes,12,opt,name=follow,oneof"`
+}
+
+type ClientSent_DefineTeams_ struct {
+	DefineTeams *ClientSent_DefineTeams `protobuf:"bytes,13,opt,name=define_teams,json=defineTeams,oneof"`
+}
+
+type ClientSent_JoinTeam_ struct {
+	JoinTeam *ClientSent_JoinTeam `protobuf:"bytes,14,opt,name=join_team,json=joinTeam,oneof"`
+}
+
+type ClientSent_AssignTeam_ struct {
+	AssignTeam *ClientSent_AssignTeam `protobuf:"bytes,15,opt,name=assign_team,json=assignTeam,oneof"`
+}
+
+type ClientSent_SetHandicap_ struct {
+	SetHandicap *ClientSent_SetHandicap `protobuf:"bytes,16,opt,name=set_handicap,json=setHandicap,oneof"`
+}
+
+type ClientSent_Draft_ struct {
+	Draft *ClientSent_Draft `protobuf:"bytes,17,opt,name=draft,oneof"`
+}
+
+type ClientSent_Chat_ struct {
+	Chat *ClientSent_Chat `protobuf:"bytes,18,opt,name=chat,oneof"`
+}
+
+type ClientSent_SetReady_ struct {
+	SetReady *ClientSent_SetReady `protobuf:"bytes,19,opt,name=set_ready,json=setReady,oneof"`
+}
+
+type ClientSent_ScheduleStart_ struct {
+	ScheduleStart *ClientSent_ScheduleStart `protobuf:"bytes,20,opt,name=schedule_start,json=scheduleStart,oneof"`
+}
+
+type ClientSent_SetChatDisabled_ struct {
+	SetChatDisabled *ClientSent_SetChatDisabled `protobuf:"bytes,21,opt,name=set_chat_disabled,json=setChatDisabled,oneof"`
+}
+
+func (*ClientSent_RequestStart_) isClientSent_Message() {}
+
+func (*ClientSent_Answer) isClientSent_Message() {}
+
+func (*ClientSent_RequestProblem_) isClientSent_Message() {}
+
+func (*ClientSent_RequestRematch_) isClientSent_Message() {}
+
+func (*ClientSent_Pause) isClientSent_Message() {}
+
+func (*ClientSent_Resume) isClientSent_Message() {}
+
+func (*ClientSent_RequestEnd_) isClientSent_Message() {}
+
+func (*ClientSent_TransferOwnership_) isClientSent_Message() {}
+
+func (*ClientSent_Kick) isClientSent_Message() {}
+
+func (*ClientSent_Ban) isClientSent_Message() {}
+
+func (*ClientSent_UpdateSettings_) isClientSent_Message() {}
+
+func (*ClientSent_Follow_) isClientSent_Message() {}
+
+func (*ClientSent_DefineTeams_) isClientSent_Message() {}
+
+func (*ClientSent_JoinTeam_) isClientSent_Message() {}
+
+func (*ClientSent_AssignTeam_) isClientSent_Message() {}
+
+func (*ClientSent_SetHandicap_) isClientSent_Message() {}
+
+func (*ClientSent_Draft_) isClientSent_Message() {}
+
+func (*ClientSent_Chat_) isClientSent_Message() {}
+
+func (*ClientSent_SetReady_) isClientSent_Message() {}
+
+func (*ClientSent_ScheduleStart_) isClientSent_Message() {}
+
+func (*ClientSent_SetChatDisabled_) isClientSent_Message() {}
+
+type CreateLobbyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyName *string `protobuf:"bytes,1,req,name=lobby_name,json=lobbyName" json:"lobby_name,omitempty"`
+	// Public lobbies are listed by the lobby browser
+	IsPublic *bool `protobuf:"varint,2,opt,name=is_public,json=isPublic" json:"is_public,omitempty"`
+	// When the game should start by itself, if it should
+	ScheduledStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_start,json=scheduledStart" json:"scheduled_start,omitempty"`
+}
+
+func (x *CreateLobbyReq) Reset() {
+	*x = CreateLobbyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLobbyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLobbyReq) ProtoMessage() {}
+
+func (x *CreateLobbyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLobbyReq.ProtoReflect.Descriptor instead.
+func (*CreateLobbyReq) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateLobbyReq) GetLobbyName() string {
+	if x != nil && x.LobbyName != nil {
+		return *x.LobbyName
+	}
+	return ""
+}
+
+func (x *CreateLobbyReq) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
+}
+
+func (x *CreateLobbyReq) GetScheduledStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledStart
+	}
+	return nil
+}
+
+type CreateLobbyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId *string `protobuf:"bytes,1,req,name=lobby_id,json=lobbyId" json:"lobby_id,omitempty"`
+}
+
+func (x *CreateLobbyRes) Reset() {
+	*x = CreateLobbyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLobbyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLobbyRes) ProtoMessage() {}
+
+func (x *CreateLobbyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLobbyRes.ProtoReflect.Descriptor instead.
+func (*CreateLobbyRes) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateLobbyRes) GetLobbyId() string {
+	if x != nil && x.LobbyId != nil {
+		return *x.LobbyId
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username *string `protobuf:"bytes,1,req,name=username" json:"username,omitempty"`
+	Password *string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	LobbyId  *string `protobuf:"bytes,3,req,name=lobby_id,json=lobbyId" json:"lobby_id,omitempty"`
+	// Spectators watch the game without playing (and don't need a password)
+	Spectator *bool `protobuf:"varint,4,opt,name=spectator" json:"spectator,omitempty"`
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoginRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetLobbyId() string {
+	if x != nil && x.LobbyId != nil {
+		return *x.LobbyId
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetSpectator() bool {
+	if x != nil && x.Spectator != nil {
+		return *x.Spectator
+	}
+	return false
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Otp         *string `protobuf:"bytes,1,req,name=otp" json:"otp,omitempty"`
+	IsOwner     *bool   `protobuf:"varint,2,req,name=is_owner,json=isOwner" json:"is_owner,omitempty"`
+	IsSpectator *bool   `protobuf:"varint,3,opt,name=is_spectator,json=isSpectator" json:"is_spectator,omitempty"`
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LoginResponse) GetOtp() string {
+	if x != nil && x.Otp != nil {
+		return *x.Otp
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetIsOwner() bool {
+	if x != nil && x.IsOwner != nil {
+		return *x.IsOwner
+	}
+	return false
+}
+
+func (x *LoginResponse) GetIsSpectator() bool {
+	if x != nil && x.IsSpectator != nil {
+		return *x.IsSpectator
+	}
+	return false
+}
+
+type ServerSent_RemoveMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ServerSent_RemoveMember) Reset() {
+	*x = ServerSent_RemoveMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_RemoveMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_RemoveMember) ProtoMessage() {}
+
+func (x *ServerSent_RemoveMember) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_RemoveMember.ProtoReflect.Descriptor instead.
+func (*ServerSent_RemoveMember) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *ServerSent_RemoveMember) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ServerSent_AddMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ServerSent_AddMember) Reset() {
+	*x = ServerSent_AddMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_AddMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_AddMember) ProtoMessage() {}
+
+func (x *ServerSent_AddMember) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_AddMember.ProtoReflect.Descriptor instead.
+func (*ServerSent_AddMember) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *ServerSent_AddMember) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ServerSent_StartGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,req,name=startTime" json:"startTime,omitempty"`
+	Duration  *timestamppb.Timestamp `protobuf:"bytes,2,req,name=duration" json:"duration,omitempty"`
+}
+
+func (x *ServerSent_StartGame) Reset() {
+	*x = ServerSent_StartGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_StartGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_StartGame) ProtoMessage() {}
+
+func (x *ServerSent_StartGame) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_StartGame.ProtoReflect.Descriptor instead.
+func (*ServerSent_StartGame) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 2}
+}
+
+func (x *ServerSent_StartGame) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ServerSent_StartGame) GetDuration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type ServerSent_EndGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whoever won outright, by meeting the win condition or outlasting everyone else
+	Winner *string `protobuf:"bytes,1,opt,name=winner" json:"winner,omitempty"`
+}
+
+func (x *ServerSent_EndGame) Reset() {
+	*x = ServerSent_EndGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_EndGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_EndGame) ProtoMessage() {}
+
+func (x *ServerSent_EndGame) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_EndGame.ProtoReflect.Descriptor instead.
+func (*ServerSent_EndGame) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 3}
+}
+
+func (x *ServerSent_EndGame) GetWinner() string {
+	if x != nil && x.Winner != nil {
+		return *x.Winner
+	}
+	return ""
+}
+
+type ServerSent_NewProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Problem *Problem `protobuf:"bytes,1,req,name=problem" json:"problem,omitempty"`
+}
+
+func (x *ServerSent_NewProblem) Reset() {
+	*x = ServerSent_NewProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_NewProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_NewProblem) ProtoMessage() {}
+
+func (x *ServerSent_NewProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_NewProblem.ProtoReflect.Descriptor instead.
+func (*ServerSent_NewProblem) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 4}
+}
+
+func (x *ServerSent_NewProblem) GetProblem() *Problem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+type ServerSent_ScoreUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Score *int32  `protobuf:"varint,2,req,name=score" json:"score,omitempty"`
+	// Every team's total, if the lobby has teams
+	TeamScores []*ServerSent_ScoreUpdate_TeamScore `protobuf:"bytes,3,rep,name=team_scores,json=teamScores" json:"team_scores,omitempty"`
+}
+
+func (x *ServerSent_ScoreUpdate) Reset() {
+	*x = ServerSent_ScoreUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_ScoreUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_ScoreUpdate) ProtoMessage() {}
+
+func (x *ServerSent_ScoreUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_ScoreUpdate.ProtoReflect.Descriptor instead.
+func (*ServerSent_ScoreUpdate) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 5}
+}
+
+func (x *ServerSent_ScoreUpdate) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerSent_ScoreUpdate) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *ServerSent_ScoreUpdate) GetTeamScores() []*ServerSent_ScoreUpdate_TeamScore {
+	if x != nil {
+		return x.TeamScores
+	}
+	return nil
+}
+
+type ServerSent_WrongAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerSent_WrongAnswer) Reset() {
+	*x = ServerSent_WrongAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_WrongAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_WrongAnswer) ProtoMessage() {}
+
+func (x *ServerSent_WrongAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_WrongAnswer.ProtoReflect.Descriptor instead.
+func (*ServerSent_WrongAnswer) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 6}
+}
+
+type ServerSent_Rematch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerSent_Rematch) Reset() {
+	*x = ServerSent_Rematch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Rematch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Rematch) ProtoMessage() {}
+
+func (x *ServerSent_Rematch) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Rematch.ProtoReflect.Descriptor instead.
+func (*ServerSent_Rematch) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 7}
+}
+
+type ServerSent_Countdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,1,req,name=startTime" json:"startTime,omitempty"`
+	ServerTime *timestamppb.Timestamp `protobuf:"bytes,2,req,name=serverTime" json:"serverTime,omitempty"`
+}
+
+func (x *ServerSent_Countdown) Reset() {
+	*x = ServerSent_Countdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Countdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Countdown) ProtoMessage() {}
+
+func (x *ServerSent_Countdown) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Countdown.ProtoReflect.Descriptor instead.
+func (*ServerSent_Countdown) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 8}
+}
+
+func (x *ServerSent_Countdown) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ServerSent_Countdown) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+// remaining is 0 if the game has no time limit
+type ServerSent_Paused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining *timestamppb.Timestamp `protobuf:"bytes,1,req,name=remaining" json:"remaining,omitempty"`
+}
+
+func (x *ServerSent_Paused) Reset() {
+	*x = ServerSent_Paused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Paused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Paused) ProtoMessage() {}
+
+func (x *ServerSent_Paused) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Paused.ProtoReflect.Descriptor instead.
+func (*ServerSent_Paused) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 9}
+}
+
+func (x *ServerSent_Paused) GetRemaining() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+type ServerSent_Resumed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining *timestamppb.Timestamp `protobuf:"bytes,1,req,name=remaining" json:"remaining,omitempty"`
+}
+
+func (x *ServerSent_Resumed) Reset() {
+	*x = ServerSent_Resumed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Resumed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Resumed) ProtoMessage() {}
+
+func (x *ServerSent_Resumed) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Resumed.ProtoReflect.Descriptor instead.
+func (*ServerSent_Resumed) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 10}
+}
+
+func (x *ServerSent_Resumed) GetRemaining() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+type ServerSent_OwnerChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ServerSent_OwnerChanged) Reset() {
+	*x = ServerSent_OwnerChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_OwnerChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_OwnerChanged) ProtoMessage() {}
+
+func (x *ServerSent_OwnerChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_OwnerChanged.ProtoReflect.Descriptor instead.
+func (*ServerSent_OwnerChanged) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 11}
+}
+
+func (x *ServerSent_OwnerChanged) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ServerSent_Settings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *LobbySettings `protobuf:"bytes,1,req,name=settings" json:"settings,omitempty"`
+}
+
+func (x *ServerSent_Settings) Reset() {
+	*x = ServerSent_Settings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Settings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Settings) ProtoMessage() {}
+
+func (x *ServerSent_Settings) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Settings.ProtoReflect.Descriptor instead.
+func (*ServerSent_Settings) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 12}
+}
+
+func (x *ServerSent_Settings) GetSettings() *LobbySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ServerSent_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason *string `protobuf:"bytes,1,req,name=reason" json:"reason,omitempty"`
+}
+
+func (x *ServerSent_Error) Reset() {
+	*x = ServerSent_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Error) ProtoMessage() {}
+
+func (x *ServerSent_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Error.ProtoReflect.Descriptor instead.
+func (*ServerSent_Error) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 13}
+}
+
+func (x *ServerSent_Error) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// Sent to spectators following a player whenever that player's problem changes
+type ServerSent_FollowedProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Problem *Problem `protobuf:"bytes,2,req,name=problem" json:"problem,omitempty"`
+}
+
+func (x *ServerSent_FollowedProblem) Reset() {
+	*x = ServerSent_FollowedProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_FollowedProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_FollowedProblem) ProtoMessage() {}
+
+func (x *ServerSent_FollowedProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_FollowedProblem.ProtoReflect.Descriptor instead.
+func (*ServerSent_FollowedProblem) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 14}
+}
+
+func (x *ServerSent_FollowedProblem) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerSent_FollowedProblem) GetProblem() *Problem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+type ServerSent_Teams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams []*Team `protobuf:"bytes,1,rep,name=teams" json:"teams,omitempty"`
+}
+
+func (x *ServerSent_Teams) Reset() {
+	*x = ServerSent_Teams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Teams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Teams) ProtoMessage() {}
+
+func (x *ServerSent_Teams) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Teams.ProtoReflect.Descriptor instead.
+func (*ServerSent_Teams) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 15}
+}
+
+func (x *ServerSent_Teams) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// Sent to everyone when a race round starts (or to catch up someone connecting mid-round)
+type ServerSent_RoundStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round   *int32   `protobuf:"varint,1,req,name=round" json:"round,omitempty"`
+	Problem *Problem `protobuf:"bytes,2,req,name=problem" json:"problem,omitempty"`
+	// How long is left in the round, if it has a time limit
+	Remaining *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=remaining" json:"remaining,omitempty"`
+}
+
+func (x *ServerSent_RoundStart) Reset() {
+	*x = ServerSent_RoundStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_RoundStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_RoundStart) ProtoMessage() {}
+
+func (x *ServerSent_RoundStart) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_RoundStart.ProtoReflect.Descriptor instead.
+func (*ServerSent_RoundStart) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 16}
+}
+
+func (x *ServerSent_RoundStart) GetRound() int32 {
+	if x != nil && x.Round != nil {
+		return *x.Round
+	}
+	return 0
+}
+
+func (x *ServerSent_RoundStart) GetProblem() *Problem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+func (x *ServerSent_RoundStart) GetRemaining() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+type ServerSent_RoundEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round *int32 `protobuf:"varint,1,req,name=round" json:"round,omitempty"`
+	// In the order they solved the round's problem
+	Finishers []*ServerSent_RoundEnd_Finisher `protobuf:"bytes,2,rep,name=finishers" json:"finishers,omitempty"`
+	// How long until the next round starts
+	Intermission *timestamppb.Timestamp `protobuf:"bytes,3,req,name=intermission" json:"intermission,omitempty"`
+}
+
+func (x *ServerSent_RoundEnd) Reset() {
+	*x = ServerSent_RoundEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_RoundEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_RoundEnd) ProtoMessage() {}
+
+func (x *ServerSent_RoundEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_RoundEnd.ProtoReflect.Descriptor instead.
+func (*ServerSent_RoundEnd) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 17}
+}
+
+func (x *ServerSent_RoundEnd) GetRound() int32 {
+	if x != nil && x.Round != nil {
+		return *x.Round
+	}
+	return 0
+}
+
+func (x *ServerSent_RoundEnd) GetFinishers() []*ServerSent_RoundEnd_Finisher {
+	if x != nil {
+		return x.Finishers
+	}
+	return nil
+}
+
+func (x *ServerSent_RoundEnd) GetIntermission() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Intermission
+	}
+	return nil
+}
+
+// Sent whenever a member's details change, and on connecting for each member with any
+type ServerSent_MemberUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member *Member `protobuf:"bytes,1,req,name=member" json:"member,omitempty"`
+}
+
+func (x *ServerSent_MemberUpdate) Reset() {
+	*x = ServerSent_MemberUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_MemberUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_MemberUpdate) ProtoMessage() {}
+
+func (x *ServerSent_MemberUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_MemberUpdate.ProtoReflect.Descriptor instead.
+func (*ServerSent_MemberUpdate) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 18}
+}
+
+func (x *ServerSent_MemberUpdate) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// How close a player's draft answer is to their problem's solution, from 0 to 100; sent without a seq,
+// and not replayed when a session's resumed
+type ServerSent_Progress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Percent *int32  `protobuf:"varint,2,req,name=percent" json:"percent,omitempty"`
+}
+
+func (x *ServerSent_Progress) Reset() {
+	*x = ServerSent_Progress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Progress) ProtoMessage() {}
+
+func (x *ServerSent_Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Progress.ProtoReflect.Descriptor instead.
+func (*ServerSent_Progress) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 19}
+}
+
+func (x *ServerSent_Progress) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerSent_Progress) GetPercent() int32 {
+	if x != nil && x.Percent != nil {
+		return *x.Percent
+	}
+	return 0
+}
+
+type ServerSent_Chat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Text *string `protobuf:"bytes,2,req,name=text" json:"text,omitempty"`
+	// When the server received the message
+	SentAt *timestamppb.Timestamp `protobuf:"bytes,3,req,name=sent_at,json=sentAt" json:"sent_at,omitempty"`
+}
+
+func (x *ServerSent_Chat) Reset() {
+	*x = ServerSent_Chat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Chat) ProtoMessage() {}
+
+func (x *ServerSent_Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Chat.ProtoReflect.Descriptor instead.
+func (*ServerSent_Chat) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 20}
+}
+
+func (x *ServerSent_Chat) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerSent_Chat) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *ServerSent_Chat) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+// Sent when the owner schedules (or cancels) a start, then as a reminder as the start gets closer
+type ServerSent_Scheduled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unset if the scheduled start was cancelled
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+	ServerTime *timestamppb.Timestamp `protobuf:"bytes,2,req,name=server_time,json=serverTime" json:"server_time,omitempty"`
+}
+
+func (x *ServerSent_Scheduled) Reset() {
+	*x = ServerSent_Scheduled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Scheduled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Scheduled) ProtoMessage() {}
+
+func (x *ServerSent_Scheduled) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Scheduled.ProtoReflect.Descriptor instead.
+func (*ServerSent_Scheduled) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 21}
+}
+
+func (x *ServerSent_Scheduled) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ServerSent_Scheduled) GetServerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+type ServerSent_Eliminated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// Where the player finished, 1 being the winner
+	Place *int32 `protobuf:"varint,2,req,name=place" json:"place,omitempty"`
+}
+
+func (x *ServerSent_Eliminated) Reset() {
+	*x = ServerSent_Eliminated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Eliminated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Eliminated) ProtoMessage() {}
+
+func (x *ServerSent_Eliminated) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Eliminated.ProtoReflect.Descriptor instead.
+func (*ServerSent_Eliminated) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 22}
+}
+
+func (x *ServerSent_Eliminated) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerSent_Eliminated) GetPlace() int32 {
+	if x != nil && x.Place != nil {
+		return *x.Place
+	}
+	return 0
+}
+
+// Sent (without a seq) when a client connects; reconnecting with the token and the last seq received
+// resumes the session, replaying whatever was missed
+type ServerSent_Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token *string `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	// Set when the connection resumed a session, and the missed messages follow
+	Resumed *bool `protobuf:"varint,2,opt,name=resumed" json:"resumed,omitempty"`
+}
+
+func (x *ServerSent_Session) Reset() {
+	*x = ServerSent_Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_Session) ProtoMessage() {}
+
+func (x *ServerSent_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_Session.ProtoReflect.Descriptor instead.
+func (*ServerSent_Session) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 23}
+}
+
+func (x *ServerSent_Session) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *ServerSent_Session) GetResumed() bool {
+	if x != nil && x.Resumed != nil {
+		return *x.Resumed
+	}
+	return false
+}
+
+// Everything about the lobby a client needs to know when it connects
+type ServerSent_LobbySnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members  []*Member      `protobuf:"bytes,1,rep,name=members" json:"members,omitempty"`
+	Settings *LobbySettings `protobuf:"bytes,2,req,name=settings" json:"settings,omitempty"`
+	Owner    *string        `protobuf:"bytes,3,opt,name=owner" json:"owner,omitempty"`
+	// One of the lobby statuses, e.g. "waiting" or "playing"
+	State *string `protobuf:"bytes,4,req,name=state" json:"state,omitempty"`
+	// What's left on the game clock, if a game's underway and has a time limit
+	Remaining *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=remaining" json:"remaining,omitempty"`
+	// The running totals, if a match is being (or has just been) played
+	MatchStandings []*Standing `protobuf:"bytes,6,rep,name=match_standings,json=matchStandings" json:"match_standings,omitempty"`
+}
+
+func (x *ServerSent_LobbySnapshot) Reset() {
+	*x = ServerSent_LobbySnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_LobbySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_LobbySnapshot) ProtoMessage() {}
+
+func (x *ServerSent_LobbySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_LobbySnapshot.ProtoReflect.Descriptor instead.
+func (*ServerSent_LobbySnapshot) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 24}
+}
+
+func (x *ServerSent_LobbySnapshot) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ServerSent_LobbySnapshot) GetSettings() *LobbySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ServerSent_LobbySnapshot) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
+func (x *ServerSent_LobbySnapshot) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *ServerSent_LobbySnapshot) GetRemaining() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Remaining
+	}
+	return nil
+}
+
+func (x *ServerSent_LobbySnapshot) GetMatchStandings() []*Standing {
+	if x != nil {
+		return x.MatchStandings
+	}
+	return nil
+}
+
+// Sent after each round of a match, with everyone's scores for the round and in total so far
+type ServerSent_MatchRoundEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Starting from 1
+	Round          *int32      `protobuf:"varint,1,req,name=round" json:"round,omitempty"`
+	Rounds         *int32      `protobuf:"varint,2,req,name=rounds" json:"rounds,omitempty"`
+	RoundStandings []*Standing `protobuf:"bytes,3,rep,name=round_standings,json=roundStandings" json:"round_standings,omitempty"`
+	Standings      []*Standing `protobuf:"bytes,4,rep,name=standings" json:"standings,omitempty"`
+	// Unset if that was the last round
+	NextRoundStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_round_start,json=nextRoundStart" json:"next_round_start,omitempty"`
+}
+
+func (x *ServerSent_MatchRoundEnd) Reset() {
+	*x = ServerSent_MatchRoundEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_MatchRoundEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_MatchRoundEnd) ProtoMessage() {}
+
+func (x *ServerSent_MatchRoundEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_MatchRoundEnd.ProtoReflect.Descriptor instead.
+func (*ServerSent_MatchRoundEnd) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 25}
+}
+
+func (x *ServerSent_MatchRoundEnd) GetRound() int32 {
+	if x != nil && x.Round != nil {
+		return *x.Round
+	}
+	return 0
+}
+
+func (x *ServerSent_MatchRoundEnd) GetRounds() int32 {
+	if x != nil && x.Rounds != nil {
+		return *x.Rounds
+	}
+	return 0
+}
+
+func (x *ServerSent_MatchRoundEnd) GetRoundStandings() []*Standing {
+	if x != nil {
+		return x.RoundStandings
+	}
+	return nil
+}
+
+func (x *ServerSent_MatchRoundEnd) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *ServerSent_MatchRoundEnd) GetNextRoundStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRoundStart
+	}
+	return nil
+}
+
+type ServerSent_ScoreUpdate_TeamScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team  *string `protobuf:"bytes,1,req,name=team" json:"team,omitempty"`
+	Score *int32  `protobuf:"varint,2,req,name=score" json:"score,omitempty"`
+}
+
+func (x *ServerSent_ScoreUpdate_TeamScore) Reset() {
+	*x = ServerSent_ScoreUpdate_TeamScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_ScoreUpdate_TeamScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_ScoreUpdate_TeamScore) ProtoMessage() {}
+
+func (x *ServerSent_ScoreUpdate_TeamScore) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_ScoreUpdate_TeamScore.ProtoReflect.Descriptor instead.
+func (*ServerSent_ScoreUpdate_TeamScore) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 5, 0}
+}
+
+func (x *ServerSent_ScoreUpdate_TeamScore) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return ""
+}
+
+func (x *ServerSent_ScoreUpdate_TeamScore) GetScore() int32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+type ServerSent_RoundEnd_Finisher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Position *int32  `protobuf:"varint,2,req,name=position" json:"position,omitempty"`
+	Points   *int32  `protobuf:"varint,3,req,name=points" json:"points,omitempty"`
+}
+
+func (x *ServerSent_RoundEnd_Finisher) Reset() {
+	*x = ServerSent_RoundEnd_Finisher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerSent_RoundEnd_Finisher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSent_RoundEnd_Finisher) ProtoMessage() {}
+
+func (x *ServerSent_RoundEnd_Finisher) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSent_RoundEnd_Finisher.ProtoReflect.Descriptor instead.
+func (*ServerSent_RoundEnd_Finisher) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{11, 17, 0}
+}
+
+func (x *ServerSent_RoundEnd_Finisher) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ServerSent_RoundEnd_Finisher) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+func (x *ServerSent_RoundEnd_Finisher) GetPoints() int32 {
+	if x != nil && x.Points != nil {
+		return *x.Points
+	}
+	return 0
+}
+
+// Any of duration, is_random and countdown that are set override the lobby's settings
+type ClientSent_RequestStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=duration" json:"duration,omitempty"`
+	IsRandom  *bool                  `protobuf:"varint,2,opt,name=is_random,json=isRandom" json:"is_random,omitempty"`
+	Problems  []*Problem             `protobuf:"bytes,3,rep,name=problems" json:"problems,omitempty"`
+	Countdown *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=countdown" json:"countdown,omitempty"`
+	// Start even if not enough players are ready
+	Force *bool `protobuf:"varint,5,opt,name=force" json:"force,omitempty"`
+}
+
+func (x *ClientSent_RequestStart) Reset() {
+	*x = ClientSent_RequestStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_RequestStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_RequestStart) ProtoMessage() {}
+
+func (x *ClientSent_RequestStart) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_RequestStart.ProtoReflect.Descriptor instead.
+func (*ClientSent_RequestStart) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *ClientSent_RequestStart) GetDuration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ClientSent_RequestStart) GetIsRandom() bool {
+	if x != nil && x.IsRandom != nil {
+		return *x.IsRandom
+	}
+	return false
+}
+
+func (x *ClientSent_RequestStart) GetProblems() []*Problem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *ClientSent_RequestStart) GetCountdown() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Countdown
+	}
+	return nil
+}
+
+func (x *ClientSent_RequestStart) GetForce() bool {
+	if x != nil && x.Force != nil {
+		return *x.Force
+	}
+	return false
+}
+
+type ClientSent_GiveAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer *string `protobuf:"bytes,1,req,name=answer" json:"answer,omitempty"`
+}
+
+func (x *ClientSent_GiveAnswer) Reset() {
+	*x = ClientSent_GiveAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_GiveAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_GiveAnswer) ProtoMessage() {}
+
+func (x *ClientSent_GiveAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_GiveAnswer.ProtoReflect.Descriptor instead.
+func (*ClientSent_GiveAnswer) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *ClientSent_GiveAnswer) GetAnswer() string {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return ""
+}
+
+type ClientSent_RequestProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClientSent_RequestProblem) Reset() {
+	*x = ClientSent_RequestProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_RequestProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_RequestProblem) ProtoMessage() {}
+
+func (x *ClientSent_RequestProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_RequestProblem.ProtoReflect.Descriptor instead.
+func (*ClientSent_RequestProblem) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 2}
+}
+
+type ClientSent_RequestRematch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClientSent_RequestRematch) Reset() {
+	*x = ClientSent_RequestRematch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_RequestRematch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_RequestRematch) ProtoMessage() {}
+
+func (x *ClientSent_RequestRematch) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_RequestRematch.ProtoReflect.Descriptor instead.
+func (*ClientSent_RequestRematch) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 3}
+}
+
+type ClientSent_PauseGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClientSent_PauseGame) Reset() {
+	*x = ClientSent_PauseGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_PauseGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_PauseGame) ProtoMessage() {}
+
+func (x *ClientSent_PauseGame) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_PauseGame.ProtoReflect.Descriptor instead.
+func (*ClientSent_PauseGame) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 4}
+}
+
+type ClientSent_ResumeGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClientSent_ResumeGame) Reset() {
+	*x = ClientSent_ResumeGame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_ResumeGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_ResumeGame) ProtoMessage() {}
+
+func (x *ClientSent_ResumeGame) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_ResumeGame.ProtoReflect.Descriptor instead.
+func (*ClientSent_ResumeGame) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 5}
+}
+
+type ClientSent_RequestEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClientSent_RequestEnd) Reset() {
+	*x = ClientSent_RequestEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_RequestEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_RequestEnd) ProtoMessage() {}
+
+func (x *ClientSent_RequestEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_RequestEnd.ProtoReflect.Descriptor instead.
+func (*ClientSent_RequestEnd) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 6}
+}
+
+type ClientSent_TransferOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ClientSent_TransferOwnership) Reset() {
+	*x = ClientSent_TransferOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_TransferOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_TransferOwnership) ProtoMessage() {}
+
+func (x *ClientSent_TransferOwnership) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_TransferOwnership.ProtoReflect.Descriptor instead.
+func (*ClientSent_TransferOwnership) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 7}
+}
+
+func (x *ClientSent_TransferOwnership) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ClientSent_KickPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ClientSent_KickPlayer) Reset() {
+	*x = ClientSent_KickPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_KickPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_KickPlayer) ProtoMessage() {}
+
+func (x *ClientSent_KickPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_KickPlayer.ProtoReflect.Descriptor instead.
+func (*ClientSent_KickPlayer) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 8}
+}
+
+func (x *ClientSent_KickPlayer) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ClientSent_BanPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ClientSent_BanPlayer) Reset() {
+	*x = ClientSent_BanPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_BanPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_BanPlayer) ProtoMessage() {}
+
+func (x *ClientSent_BanPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_BanPlayer.ProtoReflect.Descriptor instead.
+func (*ClientSent_BanPlayer) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 9}
+}
+
+func (x *ClientSent_BanPlayer) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// Only the fields that are set are changed
+type ClientSent_UpdateSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *LobbySettings `protobuf:"bytes,1,req,name=settings" json:"settings,omitempty"`
+}
+
+func (x *ClientSent_UpdateSettings) Reset() {
+	*x = ClientSent_UpdateSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_UpdateSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_UpdateSettings) ProtoMessage() {}
+
+func (x *ClientSent_UpdateSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_UpdateSettings.ProtoReflect.Descriptor instead.
+func (*ClientSent_UpdateSettings) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 10}
+}
+
+func (x *ClientSent_UpdateSettings) GetSettings() *LobbySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Sent by spectators (and eliminated players) to pick which player's problems they see
+type ClientSent_Follow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ClientSent_Follow) Reset() {
+	*x = ClientSent_Follow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_Follow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_Follow) ProtoMessage() {}
+
+func (x *ClientSent_Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_Follow.ProtoReflect.Descriptor instead.
+func (*ClientSent_Follow) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 11}
+}
+
+func (x *ClientSent_Follow) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// Sent by the owner to replace the lobby's teams; an empty list means no teams
+type ClientSent_DefineTeams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
+}
+
+func (x *ClientSent_DefineTeams) Reset() {
+	*x = ClientSent_DefineTeams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_DefineTeams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_DefineTeams) ProtoMessage() {}
+
+func (x *ClientSent_DefineTeams) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_DefineTeams.ProtoReflect.Descriptor instead.
+func (*ClientSent_DefineTeams) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 12}
+}
+
+func (x *ClientSent_DefineTeams) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type ClientSent_JoinTeam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Team *string `protobuf:"bytes,1,req,name=team" json:"team,omitempty"`
+}
+
+func (x *ClientSent_JoinTeam) Reset() {
+	*x = ClientSent_JoinTeam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_JoinTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_JoinTeam) ProtoMessage() {}
+
+func (x *ClientSent_JoinTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_JoinTeam.ProtoReflect.Descriptor instead.
+func (*ClientSent_JoinTeam) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 13}
+}
+
+func (x *ClientSent_JoinTeam) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return ""
+}
+
+// Sent by the owner to put a player in a team, or take them out of one with an empty team
+type ClientSent_AssignTeam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Team *string `protobuf:"bytes,2,req,name=team" json:"team,omitempty"`
+}
+
+func (x *ClientSent_AssignTeam) Reset() {
+	*x = ClientSent_AssignTeam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_AssignTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_AssignTeam) ProtoMessage() {}
+
+func (x *ClientSent_AssignTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_AssignTeam.ProtoReflect.Descriptor instead.
+func (*ClientSent_AssignTeam) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 14}
+}
+
+func (x *ClientSent_AssignTeam) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ClientSent_AssignTeam) GetTeam() string {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return ""
+}
+
+// Sent (throttled) while a player's typing; only how close it is is shared, never the answer itself
+type ClientSent_Draft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answer *string `protobuf:"bytes,1,req,name=answer" json:"answer,omitempty"`
+}
+
+func (x *ClientSent_Draft) Reset() {
+	*x = ClientSent_Draft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_Draft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_Draft) ProtoMessage() {}
+
+func (x *ClientSent_Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_Draft.ProtoReflect.Descriptor instead.
+func (*ClientSent_Draft) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 15}
+}
+
+func (x *ClientSent_Draft) GetAnswer() string {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return ""
+}
+
+type ClientSent_Chat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text *string `protobuf:"bytes,1,req,name=text" json:"text,omitempty"`
+}
+
+func (x *ClientSent_Chat) Reset() {
+	*x = ClientSent_Chat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_Chat) ProtoMessage() {}
+
+func (x *ClientSent_Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_Chat.ProtoReflect.Descriptor instead.
+func (*ClientSent_Chat) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 16}
+}
+
+func (x *ClientSent_Chat) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+type ClientSent_SetReady struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ready *bool `protobuf:"varint,1,req,name=ready" json:"ready,omitempty"`
+}
+
+func (x *ClientSent_SetReady) Reset() {
+	*x = ClientSent_SetReady{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_SetReady) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_SetReady) ProtoMessage() {}
+
+func (x *ClientSent_SetReady) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_SetReady.ProtoReflect.Descriptor instead.
+func (*ClientSent_SetReady) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 17}
+}
+
+func (x *ClientSent_SetReady) GetReady() bool {
+	if x != nil && x.Ready != nil {
+		return *x.Ready
+	}
+	return false
+}
+
+// Sent by the owner to have the game start by itself at a given time; an unset time cancels it
+type ClientSent_ScheduleStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime" json:"start_time,omitempty"`
+}
+
+func (x *ClientSent_ScheduleStart) Reset() {
+	*x = ClientSent_ScheduleStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_ScheduleStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_ScheduleStart) ProtoMessage() {}
+
+func (x *ClientSent_ScheduleStart) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_ScheduleStart.ProtoReflect.Descriptor instead.
+func (*ClientSent_ScheduleStart) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 18}
+}
+
+func (x *ClientSent_ScheduleStart) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+// Sent by the owner to replace a player's handicap; an empty handicap removes it
+type ClientSent_SetHandicap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     *string   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Handicap *Handicap `protobuf:"bytes,2,req,name=handicap" json:"handicap,omitempty"`
+}
+
+func (x *ClientSent_SetHandicap) Reset() {
+	*x = ClientSent_SetHandicap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_SetHandicap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_SetHandicap) ProtoMessage() {}
+
+func (x *ClientSent_SetHandicap) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_SetHandicap.ProtoReflect.Descriptor instead.
+func (*ClientSent_SetHandicap) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 19}
+}
+
+func (x *ClientSent_SetHandicap) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ClientSent_SetHandicap) GetHandicap() *Handicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
+// Sent by the owner to stop (or let) players chatting while the game's being played; unlike the rest of
+// the settings, this can be changed during the game
+type ClientSent_SetChatDisabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disabled *bool `protobuf:"varint,1,req,name=disabled" json:"disabled,omitempty"`
+}
+
+func (x *ClientSent_SetChatDisabled) Reset() {
+	*x = ClientSent_SetChatDisabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_passing_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientSent_SetChatDisabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSent_SetChatDisabled) ProtoMessage() {}
+
+func (x *ClientSent_SetChatDisabled) ProtoReflect() protoreflect.Message {
+	mi := &file_message_passing_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSent_SetChatDisabled.ProtoReflect.Descriptor instead.
+func (*ClientSent_SetChatDisabled) Descriptor() ([]byte, []int) {
+	return file_message_passing_proto_rawDescGZIP(), []int{12, 20}
+}
+
+func (x *ClientSent_SetChatDisabled) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+var File_message_passing_proto protoreflect.FileDescriptor
+
+var file_message_passing_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x74, 0x65, 0x78, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x74, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xb3, 0x01, 0x0a, 0x13, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x65, 0x72, 0x5f, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x64,
+	0x65, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x75, 0x64, 0x64,
+	0x65, 0x6e, 0x44, 0x65, 0x61, 0x74, 0x68, 0x22, 0x56, 0x0a, 0x0c, 0x57, 0x69, 0x6e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x9e, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0e, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x65, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0xc5, 0x07, 0x0a, 0x0d, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x2f, 0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x29, 0x0a, 0x0a, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x09, 0x6a, 0x75, 0x64, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
+	0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x14, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x74, 0x65, 0x61,
+	0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12,
+	0x26, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x09, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x67,
+	0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x04, 0x72, 0x61, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0b, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x57, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x68, 0x61, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x49, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x11, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x13,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x8f, 0x01, 0x0a, 0x08, 0x48, 0x61, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x70, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x22, 0x96, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x70, 0x52, 0x08, 0x68,
+	0x61, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x34, 0x0a, 0x08, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x34, 0x0a, 0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x94, 0x1d, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x61,
+	0x64, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x12, 0x27, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x47, 0x61,
+	0x6d, 0x65, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x77, 0x72, 0x6f, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x65, 0x6e, 0x74, 0x2e, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x2c, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x2f,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12,
+	0x3f, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x48, 0x0a, 0x10, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x65, 0x61,
+	0x6d, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x33, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x45, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3f,
+	0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x32, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x43, 0x0a, 0x0f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x6e, 0x64,
+	0x48, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x6e,
+	0x64, 0x1a, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1f, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x7d, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x21, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x1a, 0x30, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x22, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65,
+	0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x1a, 0xb2, 0x01, 0x0a, 0x0b, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0a, 0x74, 0x65,
+	0x61, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x1a, 0x35, 0x0a, 0x09, 0x54, 0x65, 0x61, 0x6d,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x1a,
+	0x0d, 0x0a, 0x0b, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0x09,
+	0x0a, 0x07, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x81, 0x01, 0x0a, 0x09, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x42, 0x0a,
+	0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x1a, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x1a, 0x22, 0x0a, 0x0c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x1a, 0x1f, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x1a, 0x49, 0x0a, 0x0f, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x1a, 0x24,
+	0x0a, 0x05, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x1a, 0x80, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x38, 0x0a,
+	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x1a, 0xf1, 0x01, 0x0a, 0x08, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x45, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x45, 0x6e, 0x64, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x72, 0x52, 0x09, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x52, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x02, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x2f, 0x0a, 0x0c, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x38, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0x63, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x1a, 0x83, 0x01, 0x0a, 0x09,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x1a, 0x36, 0x0a, 0x0a, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x02,
+	0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x1a, 0x39, 0x0a, 0x07, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x1a, 0xf8, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x0f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a,
+	0xe0, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x32, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x44, 0x0a, 0x10,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8b, 0x11,
+	0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0d,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x30, 0x0a,
+	0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12,
+	0x45, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x45, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2d, 0x0a,
+	0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x39,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x12, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x6e, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x48, 0x00, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69, 0x63,
+	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x6e, 0x74, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x04, 0x6b, 0x69, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x03, 0x62, 0x61, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e,
+	0x74, 0x2e, 0x42, 0x61, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x00, 0x52, 0x03, 0x62,
+	0x61, 0x6e, 0x12, 0x45, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x00, 0x52,
+	0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x65,
+	0x61, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x48, 0x00,
+	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x54, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64,
+	0x69, 0x63, 0x61, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x69,
+	0x63, 0x61, 0x70, 0x12, 0x29, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x48, 0x00, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x12, 0x26,
+	0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x42, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x49, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0xd9, 0x01, 0x0a, 0x0c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x12, 0x24, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x1a, 0x24, 0x0a, 0x0a, 0x47, 0x69, 0x76, 0x65, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0x10, 0x0a, 0x0e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x1a, 0x10,
+	0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x1a, 0x0b, 0x0a, 0x09, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x0c, 0x0a,
+	0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x0c, 0x0a, 0x0a, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x1a, 0x27, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x1a, 0x20, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1f, 0x0a, 0x09, 0x42, 0x61, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x1a, 0x1c, 0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x1a, 0x23, 0x0a, 0x0b, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x1e, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x65,
+	0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x1a, 0x34, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x54, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d,
+	0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x1a, 0x1f, 0x0a, 0x05,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x1a, 0x1a, 0x0a,
+	0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x20, 0x0a, 0x08, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x1a, 0x4a, 0x0a, 0x0d, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x48, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x48, 0x61,
+	0x6e, 0x64, 0x69, 0x63, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x68, 0x61,
+	0x6e, 0x64, 0x69, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x70, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x69, 0x63, 0x61,
+	0x70, 0x1a, 0x2d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22,
+	0x2b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5f, 0x0a,
+	0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6f, 0x74, 0x70, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x74, 0x70,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x02,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x73, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x2a, 0x25,
+	0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42,
+	0x4c, 0x49, 0x43, 0x10, 0x01, 0x2a, 0x23, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x01, 0x2a, 0x2f, 0x0a, 0x09, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x49, 0x4d, 0x49, 0x4c, 0x41, 0x52, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x0e, 0x4c,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x0a,
+	0x0f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x45, 0x4e, 0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x5f,
+	0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x50, 0x45, 0x43, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x22,
+	0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54,
+	0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x41, 0x43, 0x45,
+	0x10, 0x01, 0x2a, 0x47, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4c,
+	0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x51, 0x55, 0x4f,
+	0x52, 0x55, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x2a, 0x35, 0x0a, 0x08, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x46, 0x54,
+	0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
+}
+
+var (
+	file_message_passing_proto_rawDescOnce sync.Once
+	file_message_passing_proto_rawDescData = file_message_passing_proto_rawDesc
+)
+
+func file_message_passing_proto_rawDescGZIP() []byte {
+	file_message_passing_proto_rawDescOnce.Do(func() {
+		file_message_passing_proto_rawDescData = protoimpl.X.CompressGZIP(file_message_passing_proto_rawDescData)
+	})
+	return file_message_passing_proto_rawDescData
+}
+
+var file_message_passing_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_message_passing_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_message_passing_proto_goTypes = []interface{}{
+	(Visibility)(0),                          // 0: Visibility
+	(ScoringMode)(0),                         // 1: ScoringMode
+	(JudgeMode)(0),                           // 2: JudgeMode
+	(LateJoinPolicy)(0),                      // 3: LateJoinPolicy
+	(GameMode)(0),                            // 4: GameMode
+	(ReadyRequirement)(0),                    // 5: ReadyRequirement
+	(Presence)(0),                            // 6: Presence
+	(*Problem)(nil),                          // 7: Problem
+	(*RaceSettings)(nil),                     // 8: RaceSettings
+	(*EliminationSettings)(nil),              // 9: EliminationSettings
+	(*WinCondition)(nil),                     // 10: WinCondition
+	(*MatchSettings)(nil),                    // 11: MatchSettings
+	(*ProblemFilter)(nil),                    // 12: ProblemFilter
+	(*LobbySettings)(nil),                    // 13: LobbySettings
+	(*Handicap)(nil),                         // 14: Handicap
+	(*Member)(nil),                           // 15: Member
+	(*Standing)(nil),                         // 16: Standing
+	(*Team)(nil),                             // 17: Team
+	(*ServerSent)(nil),                       // 18: ServerSent
+	(*ClientSent)(nil),                       // 19: ClientSent
+	(*CreateLobbyReq)(nil),                   // 20: CreateLobbyReq
+	(*CreateLobbyRes)(nil),                   // 21: CreateLobbyRes
+	(*LoginRequest)(nil),                     // 22: LoginRequest
+	(*LoginResponse)(nil),                    // 23: LoginResponse
+	(*ServerSent_RemoveMember)(nil),          // 24: ServerSent.RemoveMember
+	(*ServerSent_AddMember)(nil),             // 25: ServerSent.AddMember
+	(*ServerSent_StartGame)(nil),             // 26: ServerSent.StartGame
+	(*ServerSent_EndGame)(nil),               // 27: ServerSent.EndGame
+	(*ServerSent_NewProblem)(nil),            // 28: ServerSent.NewProblem
+	(*ServerSent_ScoreUpdate)(nil),           // 29: ServerSent.ScoreUpdate
+	(*ServerSent_WrongAnswer)(nil),           // 30: ServerSent.WrongAnswer
+	(*ServerSent_Rematch)(nil),               // 31: ServerSent.Rematch
+	(*ServerSent_Countdown)(nil),             // 32: ServerSent.Countdown
+	(*ServerSent_Paused)(nil),                // 33: ServerSent.Paused
+	(*ServerSent_Resumed)(nil),               // 34: ServerSent.Resumed
+	(*ServerSent_OwnerChanged)(nil),          // 35: ServerSent.OwnerChanged
+	(*ServerSent_Settings)(nil),              // 36: ServerSent.Settings
+	(*ServerSent_Error)(nil),                 // 37: ServerSent.Error
+	(*ServerSent_FollowedProblem)(nil),       // 38: ServerSent.FollowedProblem
+	(*ServerSent_Teams)(nil),                 // 39: ServerSent.Teams
+	(*ServerSent_RoundStart)(nil),            // 40: ServerSent.RoundStart
+	(*ServerSent_RoundEnd)(nil),              // 41: ServerSent.RoundEnd
+	(*ServerSent_MemberUpdate)(nil),          // 42: ServerSent.MemberUpdate
+	(*ServerSent_Progress)(nil),              // 43: ServerSent.Progress
+	(*ServerSent_Chat)(nil),                  // 44: ServerSent.Chat
+	(*ServerSent_Scheduled)(nil),             // 45: ServerSent.Scheduled
+	(*ServerSent_Eliminated)(nil),            // 46: ServerSent.Eliminated
+	(*ServerSent_Session)(nil),               // 47: ServerSent.Session
+	(*ServerSent_LobbySnapshot)(nil),         // 48: ServerSent.LobbySnapshot
+	(*ServerSent_MatchRoundEnd)(nil),         // 49: ServerSent.MatchRoundEnd
+	(*ServerSent_ScoreUpdate_TeamScore)(nil), // 50: ServerSent.ScoreUpdate.TeamScore
+	(*ServerSent_RoundEnd_Finisher)(nil),     // 51: ServerSent.RoundEnd.Finisher
+	(*ClientSent_RequestStart)(nil),          // 52: ClientSent.RequestStart
+	(*ClientSent_GiveAnswer)(nil),            // 53: ClientSent.GiveAnswer
+	(*ClientSent_RequestProblem)(nil),        // 54: ClientSent.RequestProblem
+	(*ClientSent_RequestRematch)(nil),        // 55: ClientSent.RequestRematch
+	(*ClientSent_PauseGame)(nil),             // 56: ClientSent.PauseGame
+	(*ClientSent_ResumeGame)(nil),            // 57: ClientSent.ResumeGame
+	(*ClientSent_RequestEnd)(nil),            // 58: ClientSent.RequestEnd
+	(*ClientSent_TransferOwnership)(nil),     // 59: ClientSent.TransferOwnership
+	(*ClientSent_KickPlayer)(nil),            // 60: ClientSent.KickPlayer
+	(*ClientSent_BanPlayer)(nil),             // 61: ClientSent.BanPlayer
+	(*ClientSent_UpdateSettings)(nil),        // 62: ClientSent.UpdateSettings
+	(*ClientSent_Follow)(nil),                // 63: ClientSent.Follow
+	(*ClientSent_DefineTeams)(nil),           // 64: ClientSent.DefineTeams
+	(*ClientSent_JoinTeam)(nil),              // 65: ClientSent.JoinTeam
+	(*ClientSent_AssignTeam)(nil),            // 66: ClientSent.AssignTeam
+	(*ClientSent_Draft)(nil),                 // 67: ClientSent.Draft
+	(*ClientSent_Chat)(nil),                  // 68: ClientSent.Chat
+	(*ClientSent_SetReady)(nil),              // 69: ClientSent.SetReady
+	(*ClientSent_ScheduleStart)(nil),         // 70: ClientSent.ScheduleStart
+	(*ClientSent_SetHandicap)(nil),           // 71: ClientSent.SetHandicap
+	(*ClientSent_SetChatDisabled)(nil),       // 72: ClientSent.SetChatDisabled
+	(*timestamppb.Timestamp)(nil),            // 73: google.protobuf.Timestamp
+}
+var file_message_passing_proto_depIdxs = []int32{
+	73,  // 0: RaceSettings.round_time_limit:type_name -> google.protobuf.Timestamp
+	73,  // 1: RaceSettings.intermission:type_name -> google.protobuf.Timestamp
+	73,  // 2: EliminationSettings.interval:type_name -> google.protobuf.Timestamp
+	73,  // 3: MatchSettings.intermission:type_name -> google.protobuf.Timestamp
+	13,  // 4: MatchSettings.round_settings:type_name -> LobbySettings
+	73,  // 5: LobbySettings.duration:type_name -> google.protobuf.Timestamp
+	0,   // 6: LobbySettings.visibility:type_name -> Visibility
+	1,   // 7: LobbySettings.scoring_mode:type_name -> ScoringMode
+	2,   // 8: LobbySettings.judge_mode:type_name -> JudgeMode
+	12,  // 9: LobbySettings.problem_filter:type_name -> ProblemFilter
+	3,   // 10: LobbySettings.late_join:type_name -> LateJoinPolicy
+	73,  // 11: LobbySettings.countdown:type_name -> google.protobuf.Timestamp
+	4,   // 12: LobbySettings.game_mode:type_name -> GameMode
+	8,   // 13: LobbySettings.race:type_name -> RaceSettings
+	9,   // 14: LobbySettings.elimination:type_name -> EliminationSettings
+	10,  // 15: LobbySettings.win_condition:type_name -> WinCondition
+	5,   // 16: LobbySettings.ready_requirement:type_name -> ReadyRequirement
+	73,  // 17: LobbySettings.leave_after:type_name -> google.protobuf.Timestamp
+	11,  // 18: LobbySettings.match:type_name -> MatchSettings
+	73,  // 19: Handicap.extra_time:type_name -> google.protobuf.Timestamp
+	14,  // 20: Member.handicap:type_name -> Handicap
+	6,   // 21: Member.presence:type_name -> Presence
+	24,  // 22: ServerSent.remove:type_name -> ServerSent.RemoveMember
+	25,  // 23: ServerSent.add:type_name -> ServerSent.AddMember
+	26,  // 24: ServerSent.start:type_name -> ServerSent.StartGame
+	28,  // 25: ServerSent.new_problem:type_name -> ServerSent.NewProblem
+	27,  // 26: ServerSent.end:type_name -> ServerSent.EndGame
+	29,  // 27: ServerSent.score_update:type_name -> ServerSent.ScoreUpdate
+	30,  // 28: ServerSent.wrong:type_name -> ServerSent.WrongAnswer
+	31,  // 29: ServerSent.rematch:type_name -> ServerSent.Rematch
+	32,  // 30: ServerSent.countdown:type_name -> ServerSent.Countdown
+	33,  // 31: ServerSent.paused:type_name -> ServerSent.Paused
+	34,  // 32: ServerSent.resumed:type_name -> ServerSent.Resumed
+	35,  // 33: ServerSent.owner_changed:type_name -> ServerSent.OwnerChanged
+	36,  // 34: ServerSent.settings:type_name -> ServerSent.Settings
+	37,  // 35: ServerSent.error:type_name -> ServerSent.Error
+	38,  // 36: ServerSent.followed_problem:type_name -> ServerSent.FollowedProblem
+	39,  // 37: ServerSent.teams:type_name -> ServerSent.Teams
+	40,  // 38: ServerSent.round_start:type_name -> ServerSent.RoundStart
+	41,  // 39: ServerSent.round_end:type_name -> ServerSent.RoundEnd
+	46,  // 40: ServerSent.eliminated:type_name -> ServerSent.Eliminated
+	42,  // 41: ServerSent.member_update:type_name -> ServerSent.MemberUpdate
+	43,  // 42: ServerSent.progress:type_name -> ServerSent.Progress
+	44,  // 43: ServerSent.chat:type_name -> ServerSent.Chat
+	45,  // 44: ServerSent.scheduled:type_name -> ServerSent.Scheduled
+	47,  // 45: ServerSent.session:type_name -> ServerSent.Session
+	48,  // 46: ServerSent.snapshot:type_name -> ServerSent.LobbySnapshot
+	49,  // 47: ServerSent.match_round_end:type_name -> ServerSent.MatchRoundEnd
+	52,  // 48: ClientSent.request_start:type_name -> ClientSent.RequestStart
+	53,  // 49: ClientSent.answer:type_name -> ClientSent.GiveAnswer
+	54,  // 50: ClientSent.request_problem:type_name -> ClientSent.RequestProblem
+	55,  // 51: ClientSent.request_rematch:type_name -> ClientSent.RequestRematch
+	56,  // 52: ClientSent.pause:type_name -> ClientSent.PauseGame
+	57,  // 53: ClientSent.resume:type_name -> ClientSent.ResumeGame
+	58,  // 54: ClientSent.request_end:type_name -> ClientSent.RequestEnd
+	59,  // 55: ClientSent.transfer_ownership:type_name -> ClientSent.TransferOwnership
+	60,  // 56: ClientSent.kick:type_name -> ClientSent.KickPlayer
+	61,  // 57: ClientSent.ban:type_name -> ClientSent.BanPlayer
+	62,  // 58: ClientSent.update_settings:type_name -> ClientSent.UpdateSettings
+	63,  // 59: ClientSent.follow:type_name -> ClientSent.Follow
+	64,  // 60: ClientSent.define_teams:type_name -> ClientSent.DefineTeams
+	65,  // 61: ClientSent.join_team:type_name -> ClientSent.JoinTeam
+	66,  // 62: ClientSent.assign_team:type_name -> ClientSent.AssignTeam
+	71,  // 63: ClientSent.set_handicap:type_name -> ClientSent.SetHandicap
+	67,  // 64: ClientSent.draft:type_name -> ClientSent.Draft
+	68,  // 65: ClientSent.chat:type_name -> ClientSent.Chat
+	69,  // 66: ClientSent.set_ready:type_name -> ClientSent.SetReady
+	70,  // 67: ClientSent.schedule_start:type_name -> ClientSent.ScheduleStart
+	72,  // 68: ClientSent.set_chat_disabled:type_name -> ClientSent.SetChatDisabled
+	73,  // 69: CreateLobbyReq.scheduled_start:type_name -> google.protobuf.Timestamp
+	73,  // 70: ServerSent.StartGame.startTime:type_name -> google.protobuf.Timestamp
+	73,  // 71: ServerSent.StartGame.duration:type_name -> google.protobuf.Timestamp
+	7,   // 72: ServerSent.NewProblem.problem:type_name -> Problem
+	50,  // 73: ServerSent.ScoreUpdate.team_scores:type_name -> ServerSent.ScoreUpdate.TeamScore
+	73,  // 74: ServerSent.Countdown.startTime:type_name -> google.protobuf.Timestamp
+	73,  // 75: ServerSent.Countdown.serverTime:type_name -> google.protobuf.Timestamp
+	73,  // 76: ServerSent.Paused.remaining:type_name -> google.protobuf.Timestamp
+	73,  // 77: ServerSent.Resumed.remaining:type_name -> google.protobuf.Timestamp
+	13,  // 78: ServerSent.Settings.settings:type_name -> LobbySettings
+	7,   // 79: ServerSent.FollowedProblem.problem:type_name -> Problem
+	17,  // 80: ServerSent.Teams.teams:type_name -> Team
+	7,   // 81: ServerSent.RoundStart.problem:type_name -> Problem
+	73,  // 82: ServerSent.RoundStart.remaining:type_name -> google.protobuf.Timestamp
+	51,  // 83: ServerSent.RoundEnd.finishers:type_name -> ServerSent.RoundEnd.Finisher
+	73,  // 84: ServerSent.RoundEnd.intermission:type_name -> google.protobuf.Timestamp
+	15,  // 85: ServerSent.MemberUpdate.member:type_name -> Member
+	73,  // 86: ServerSent.Chat.sent_at:type_name -> google.protobuf.Timestamp
+	73,  // 87: ServerSent.Scheduled.start_time:type_name -> google.protobuf.Timestamp
+	73,  // 88: ServerSent.Scheduled.server_time:type_name -> google.protobuf.Timestamp
+	15,  // 89: ServerSent.LobbySnapshot.members:type_name -> Member
+	13,  // 90: ServerSent.LobbySnapshot.settings:type_name -> LobbySettings
+	73,  // 91: ServerSent.LobbySnapshot.remaining:type_name -> google.protobuf.Timestamp
+	16,  // 92: ServerSent.LobbySnapshot.match_standings:type_name -> Standing
+	16,  // 93: ServerSent.MatchRoundEnd.round_standings:type_name -> Standing
+	16,  // 94: ServerSent.MatchRoundEnd.standings:type_name -> Standing
+	73,  // 95: ServerSent.MatchRoundEnd.next_round_start:type_name -> google.protobuf.Timestamp
+	73,  // 96: ClientSent.RequestStart.duration:type_name -> google.protobuf.Timestamp
+	7,   // 97: ClientSent.RequestStart.problems:type_name -> Problem
+	73,  // 98: ClientSent.RequestStart.countdown:type_name -> google.protobuf.Timestamp
+	13,  // 99: ClientSent.UpdateSettings.settings:type_name -> LobbySettings
+	73,  // 100: ClientSent.ScheduleStart.start_time:type_name -> google.protobuf.Timestamp
+	14,  // 101: ClientSent.SetHandicap.handicap:type_name -> Handicap
+	102, // [102:102] is the sub-list for method output_type
+	102, // [102:102] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
+}
+
+func init() { file_message_passing_proto_init() }
+func file_message_passing_proto_init() {
+	if File_message_passing_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_message_passing_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Problem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaceSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EliminationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WinCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProblemFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LobbySettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Handicap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLobbyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLobbyRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_RemoveMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_AddMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_StartGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_EndGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_NewProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_ScoreUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_WrongAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Rematch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Countdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Paused); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Resumed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1192,8 +5960,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent); i {
+		file_message_passing_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_OwnerChanged); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1204,8 +5972,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientSent); i {
+		file_message_passing_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Settings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1216,8 +5984,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLobbyReq); i {
+		file_message_passing_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Error); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1228,8 +5996,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLobbyRes); i {
+		file_message_passing_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_FollowedProblem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1240,8 +6008,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_message_passing_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Teams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1252,8 +6020,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResponse); i {
+		file_message_passing_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_RoundStart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1264,8 +6032,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent_RemoveMember); i {
+		file_message_passing_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_RoundEnd); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1276,8 +6044,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent_AddMember); i {
+		file_message_passing_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_MemberUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1288,8 +6056,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent_StartGame); i {
+		file_message_passing_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Progress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1300,8 +6068,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent_EndGame); i {
+		file_message_passing_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Chat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1312,8 +6080,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent_NewProblem); i {
+		file_message_passing_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Scheduled); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1324,8 +6092,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent_ScoreUpdate); i {
+		file_message_passing_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Eliminated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1336,8 +6104,8 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerSent_WrongAnswer); i {
+		file_message_passing_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1348,7 +6116,55 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_message_passing_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_LobbySnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_MatchRoundEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_ScoreUpdate_TeamScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerSent_RoundEnd_Finisher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientSent_RequestStart); i {
 			case 0:
 				return &v.state
@@ -1360,7 +6176,7 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_message_passing_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientSent_GiveAnswer); i {
 			case 0:
 				return &v.state
@@ -1372,7 +6188,7 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
-		file_message_passing_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_message_passing_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientSent_RequestProblem); i {
 			case 0:
 				return &v.state
@@ -1384,8 +6200,224 @@ func file_message_passing_proto_init() {
 				return nil
 			}
 		}
+		file_message_passing_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_RequestRematch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_PauseGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_ResumeGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_RequestEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_TransferOwnership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_KickPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_BanPlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_UpdateSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_Follow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_DefineTeams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_JoinTeam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_AssignTeam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_Draft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_Chat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_SetReady); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_ScheduleStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_SetHandicap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_passing_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientSent_SetChatDisabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_message_passing_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_message_passing_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*ServerSent_Remove)(nil),
 		(*ServerSent_Add)(nil),
 		(*ServerSent_Start)(nil),
@@ -1393,24 +6425,62 @@ func file_message_passing_proto_init() {
 		(*ServerSent_End)(nil),
 		(*ServerSent_ScoreUpdate_)(nil),
 		(*ServerSent_Wrong)(nil),
+		(*ServerSent_Rematch_)(nil),
+		(*ServerSent_Countdown_)(nil),
+		(*ServerSent_Paused_)(nil),
+		(*ServerSent_Resumed_)(nil),
+		(*ServerSent_OwnerChanged_)(nil),
+		(*ServerSent_Settings_)(nil),
+		(*ServerSent_Error_)(nil),
+		(*ServerSent_FollowedProblem_)(nil),
+		(*ServerSent_Teams_)(nil),
+		(*ServerSent_RoundStart_)(nil),
+		(*ServerSent_RoundEnd_)(nil),
+		(*ServerSent_Eliminated_)(nil),
+		(*ServerSent_MemberUpdate_)(nil),
+		(*ServerSent_Progress_)(nil),
+		(*ServerSent_Chat_)(nil),
+		(*ServerSent_Scheduled_)(nil),
+		(*ServerSent_Session_)(nil),
+		(*ServerSent_Snapshot)(nil),
+		(*ServerSent_MatchRoundEnd_)(nil),
 	}
-	file_message_passing_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_message_passing_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*ClientSent_RequestStart_)(nil),
 		(*ClientSent_Answer)(nil),
 		(*ClientSent_RequestProblem_)(nil),
+		(*ClientSent_RequestRematch_)(nil),
+		(*ClientSent_Pause)(nil),
+		(*ClientSent_Resume)(nil),
+		(*ClientSent_RequestEnd_)(nil),
+		(*ClientSent_TransferOwnership_)(nil),
+		(*ClientSent_Kick)(nil),
+		(*ClientSent_Ban)(nil),
+		(*ClientSent_UpdateSettings_)(nil),
+		(*ClientSent_Follow_)(nil),
+		(*ClientSent_DefineTeams_)(nil),
+		(*ClientSent_JoinTeam_)(nil),
+		(*ClientSent_AssignTeam_)(nil),
+		(*ClientSent_SetHandicap_)(nil),
+		(*ClientSent_Draft_)(nil),
+		(*ClientSent_Chat_)(nil),
+		(*ClientSent_SetReady_)(nil),
+		(*ClientSent_ScheduleStart_)(nil),
+		(*ClientSent_SetChatDisabled_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_message_passing_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      7,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_message_passing_proto_goTypes,
 		DependencyIndexes: file_message_passing_proto_depIdxs,
+		EnumInfos:         file_message_passing_proto_enumTypes,
 		MessageInfos:      file_message_passing_proto_msgTypes,
 	}.Build()
 	File_message_passing_proto = out.File
@@ -3,6 +3,7 @@ package main
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,11 +18,17 @@ type Verifier interface {
 	VerifyOTP(otp string) bool
 }
 
-type RetentionMap map[string]OTP
+// RetentionMap holds the OTPs handed out; it's locked itself, since the retention goroutine and the
+// handlers using it don't share any other lock
+type RetentionMap struct {
+	otps map[string]OTP
+
+	sync.Mutex
+}
 
 // NewRetentionMap will create a new retentionmap and start the retention given the set period
-func NewRetentionMap(ctx context.Context, retentionPeriod time.Duration) RetentionMap {
-	rm := make(RetentionMap)
+func NewRetentionMap(ctx context.Context, retentionPeriod time.Duration) *RetentionMap {
+	rm := &RetentionMap{otps: make(map[string]OTP)}
 
 	go rm.Retention(ctx, retentionPeriod)
 
@@ -29,40 +36,47 @@ func NewRetentionMap(ctx context.Context, retentionPeriod time.Duration) Retenti
 }
 
 // NewOTP creates and adds a new otp to the map
-func (rm RetentionMap) NewOTP() OTP {
+func (rm *RetentionMap) NewOTP() OTP {
 	o := OTP{
 		Key:     uuid.NewString(),
 		Created: time.Now(),
 	}
 
-	rm[o.Key] = o
+	rm.Lock()
+	defer rm.Unlock()
+	rm.otps[o.Key] = o
 	return o
 }
 
 // VerifyOTP will make sure a OTP exists and return true if so
 // It will also delete the key so it can't be reused
-func (rm RetentionMap) VerifyOTP(otp string) bool {
+func (rm *RetentionMap) VerifyOTP(otp string) bool {
+	rm.Lock()
+	defer rm.Unlock()
+
 	// Verify OTP is existing
-	if _, ok := rm[otp]; !ok {
+	if _, ok := rm.otps[otp]; !ok {
 		// otp does not exist
 		return false
 	}
-	delete(rm, otp)
+	delete(rm.otps, otp)
 	return true
 }
 
 // Retention will make sure old OTPs are removed; this is blocking, so run as a Goroutine
-func (rm RetentionMap) Retention(ctx context.Context, retentionPeriod time.Duration) {
+func (rm *RetentionMap) Retention(ctx context.Context, retentionPeriod time.Duration) {
 	ticker := time.NewTicker(400 * time.Millisecond)
 	for {
 		select {
 		case <-ticker.C:
-			for _, otp := range rm {
+			rm.Lock()
+			for _, otp := range rm.otps {
 				// Add Retention to Created and check if it is expired
 				if otp.Created.Add(retentionPeriod).Before(time.Now()) {
-					delete(rm, otp.Key)
+					delete(rm.otps, otp.Key)
 				}
 			}
+			rm.Unlock()
 		case <-ctx.Done():
 			return
 		}
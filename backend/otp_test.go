@@ -41,11 +41,11 @@ func TestOTP_Retention(t *testing.T) {
 	otp := rm.NewOTP()
 
 	// Make sure that only 1 password is still left and it matches the latest
-	if len(rm) != 1 {
+	if len(rm.otps) != 1 {
 		t.Error("Failed to clean up")
 	}
 
-	if rm[otp.Key] != otp {
+	if rm.otps[otp.Key] != otp {
 		t.Error("The key should still be in place")
 	}
 	cancel()
@@ -0,0 +1,543 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// How long a practice login lasts, and how long a practice session can go unused before it's dropped
+const PRACTICE_TOKEN_LIFETIME = 24 * time.Hour
+const PRACTICE_SESSION_LIFETIME = 2 * time.Hour
+
+// Largest request body a practice session request can have; answers themselves are held to the same
+// limit as in a lobby
+const PRACTICE_MAX_REQUEST_SIZE = 4096
+
+// How practice answers are judged; there's no client-side check to rely on, and answers that are merely
+// similar can still be wrong (which would inflate the accuracy in a user's history)
+const PRACTICE_JUDGE_MODE = JudgeMode_EXACT
+
+// PracticeAttempt is a single answer to (or skip of) a problem in a practice session
+type PracticeAttempt struct {
+	Title   string `json:"title"`
+	Correct bool   `json:"correct"`
+	Skipped bool   `json:"skipped,omitempty"`
+	// Time since the problem was handed out
+	Seconds float64   `json:"seconds"`
+	At      time.Time `json:"at"`
+}
+
+// PracticeWeek summarises a week (starting on a Monday, in UTC) of practice attempts
+type PracticeWeek struct {
+	Start    time.Time `json:"start"`
+	Attempts int       `json:"attempts"`
+	Solved   int       `json:"solved"`
+	// Fraction of the answers given (so not counting skips) that were correct
+	Accuracy float64 `json:"accuracy"`
+	// Mean time taken to solve a problem
+	AverageSeconds float64 `json:"averageSeconds"`
+}
+
+// practiceAccount is what's saved to disk for each user who practises
+type practiceAccount struct {
+	Password string            `json:"password"`
+	Attempts []PracticeAttempt `json:"attempts"`
+
+	// version counts the changes made to the account (under the store lock), and saved is the last of
+	// them written to disk (under saveLock), so that an older snapshot never overwrites a newer one
+	version  int
+	saved    int
+	saveLock sync.Mutex
+}
+
+// accountSnapshot is an account as it was when it last changed, to be saved without holding the store lock
+type accountSnapshot struct {
+	name    string
+	account *practiceAccount
+	copy    practiceAccount
+	version int
+}
+
+type practiceToken struct {
+	name    string
+	created time.Time
+}
+
+type practiceSession struct {
+	name string
+	// indices (into the problem bank) of the problems, in the order they're handed out
+	order []int
+	index int
+	// when the current problem was handed out
+	startedAt time.Time
+	lastUsed  time.Time
+}
+
+// PracticeStore keeps track of solo practice sessions, which don't need a lobby, and saves every attempt
+// made in one so that players can look back over their history
+type PracticeStore struct {
+	dir      string
+	accounts map[string]*practiceAccount
+	tokens   map[string]practiceToken
+	sessions map[string]*practiceSession
+
+	sync.Mutex
+}
+
+func NewPracticeStore(dir string) *PracticeStore {
+	return &PracticeStore{
+		dir:      dir,
+		accounts: make(map[string]*practiceAccount),
+		tokens:   make(map[string]practiceToken),
+		sessions: make(map[string]*practiceSession),
+	}
+}
+
+func (ps *PracticeStore) accountPath(name string) string {
+	return filepath.Join(ps.dir, url.QueryEscape(name)+".json")
+}
+
+// getAccount gives the user's account, loading it from disk if it isn't already loaded; it errors if the
+// account's there but can't be loaded, so that it's never mistaken for a new one (and overwritten)
+// @dev Requires the store lock to be held
+func (ps *PracticeStore) getAccount(name string) (*practiceAccount, bool, error) {
+	if account, ok := ps.accounts[name]; ok {
+		return account, true, nil
+	}
+
+	data, err := ioutil.ReadFile(ps.accountPath(name))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	} else if err != nil {
+		log.Printf("Failed to read practice history for %s: %v\n", name, err)
+		return nil, false, err
+	}
+	var account practiceAccount
+	if err := json.Unmarshal(data, &account); err != nil {
+		log.Printf("Failed to load practice history for %s: %v\n", name, err)
+		return nil, false, err
+	}
+	ps.accounts[name] = &account
+	return &account, true, nil
+}
+
+// snapshot captures the user's account after a change, for saveAccount; attempts are only ever appended,
+// so the snapshot can share them rather than copying the whole history
+// @dev Requires the store lock to be held
+func (ps *PracticeStore) snapshot(name string) *accountSnapshot {
+	account := ps.accounts[name]
+	account.version++
+	attempts := account.Attempts[:len(account.Attempts):len(account.Attempts)]
+	return &accountSnapshot{
+		name:    name,
+		account: account,
+		copy:    practiceAccount{Password: account.Password, Attempts: attempts},
+		version: account.version,
+	}
+}
+
+// saveAccount writes the snapshot of the user's account (and so their whole history) to disk, unless a
+// newer one's been written already; it's slow, so it's done without holding the store lock
+func (ps *PracticeStore) saveAccount(snapshot *accountSnapshot) {
+	snapshot.account.saveLock.Lock()
+	defer snapshot.account.saveLock.Unlock()
+	if snapshot.version <= snapshot.account.saved {
+		return
+	}
+
+	data, err := json.Marshal(&snapshot.copy)
+	if err != nil {
+		log.Printf("Failed to save practice history for %s to JSON\n", snapshot.name)
+		return
+	}
+
+	if err := os.MkdirAll(ps.dir, os.ModePerm); err != nil {
+		log.Println("Failed to create practice directory")
+		return
+	}
+
+	if err := ioutil.WriteFile(ps.accountPath(snapshot.name), data, 0644); err != nil {
+		log.Printf("Failed to save practice history for %s to disk\n", snapshot.name)
+		return
+	}
+	snapshot.account.saved = snapshot.version
+}
+
+// userFor gives the name of the user the token was handed out to, if it's still valid
+// @dev Requires the store lock to be held
+func (ps *PracticeStore) userFor(token string) (string, bool) {
+	t, ok := ps.tokens[token]
+	if !ok || time.Since(t.created) > PRACTICE_TOKEN_LIFETIME {
+		delete(ps.tokens, token)
+		return "", false
+	}
+	return t.name, true
+}
+
+// prune drops expired logins and sessions that haven't been used in a while
+// @dev Requires the store lock to be held
+func (ps *PracticeStore) prune() {
+	for token, t := range ps.tokens {
+		if time.Since(t.created) > PRACTICE_TOKEN_LIFETIME {
+			delete(ps.tokens, token)
+		}
+	}
+	for id, session := range ps.sessions {
+		if time.Since(session.lastUsed) > PRACTICE_SESSION_LIFETIME {
+			delete(ps.sessions, id)
+		}
+	}
+}
+
+func (session *practiceSession) problem() *Problem {
+	return GetProblems()[session.order[session.index]]
+}
+
+// next moves the session on to its next problem, going round the problem bank again once it's used up
+func (session *practiceSession) next() {
+	session.index++
+	if session.index >= len(session.order) {
+		session.order = rand.Perm(len(GetProblems()))
+		session.index = 0
+	}
+	session.startedAt = time.Now()
+}
+
+// record adds an attempt at the session's current problem to the user's history, giving back the
+// snapshot to save once the store lock's released
+// @dev Requires the store lock to be held
+func (ps *PracticeStore) record(session *practiceSession, correct bool, skipped bool) (*accountSnapshot, error) {
+	account, _, err := ps.getAccount(session.name)
+	if err != nil {
+		return nil, err
+	}
+	account.Attempts = append(account.Attempts, PracticeAttempt{
+		Title:   session.problem().GetTitle(),
+		Correct: correct,
+		Skipped: skipped,
+		Seconds: time.Since(session.startedAt).Seconds(),
+		At:      time.Now(),
+	})
+	return ps.snapshot(session.name), nil
+}
+
+// weekStart is the start of the (Monday to Sunday, UTC) week the time is in
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
+
+// weeklyTrends summarises the attempts week by week, oldest first
+func weeklyTrends(attempts []PracticeAttempt) []PracticeWeek {
+	byStart := make(map[time.Time]*PracticeWeek)
+	answered := make(map[time.Time]int)
+	solveTime := make(map[time.Time]float64)
+	for _, attempt := range attempts {
+		start := weekStart(attempt.At)
+		week, ok := byStart[start]
+		if !ok {
+			week = &PracticeWeek{Start: start}
+			byStart[start] = week
+		}
+		week.Attempts++
+		if !attempt.Skipped {
+			answered[start]++
+		}
+		if attempt.Correct {
+			week.Solved++
+			solveTime[start] += attempt.Seconds
+		}
+	}
+
+	weeks := make([]PracticeWeek, 0, len(byStart))
+	for start, week := range byStart {
+		if answered[start] > 0 {
+			week.Accuracy = float64(week.Solved) / float64(answered[start])
+		}
+		if week.Solved > 0 {
+			week.AverageSeconds = solveTime[start] / float64(week.Solved)
+		}
+		weeks = append(weeks, *week)
+	}
+	sort.Slice(weeks, func(i, j int) bool {
+		return weeks[i].Start.Before(weeks[j].Start)
+	})
+	return weeks
+}
+
+func writePracticeJSON(w http.ResponseWriter, resp interface{}) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		log.Println(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// loginHandler logs a user in to practise, creating their account the first time they log in
+func (ps *PracticeStore) loginHandler(w http.ResponseWriter, r *http.Request) {
+	enableCors(&w)
+	type request struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, PRACTICE_MAX_REQUEST_SIZE)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if req.Username == "" {
+		http.Error(w, "a username is needed", http.StatusBadRequest)
+		return
+	}
+
+	ps.Lock()
+	account, exists, err := ps.getAccount(req.Username)
+	var hashedPassword string
+	if exists {
+		hashedPassword = account.Password
+	}
+	ps.Unlock()
+	if err != nil {
+		http.Error(w, "couldn't load the practice account", http.StatusInternalServerError)
+		return
+	}
+
+	// Hashing's slow, so it's done without holding the lock
+	if exists && !CheckPasswordHash(&req.Password, hashedPassword) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	} else if !exists {
+		hashed, err := HashPassword(req.Password)
+		if err != nil {
+			log.Println(err)
+			http.Error(w, "couldn't create the practice account", http.StatusInternalServerError)
+			return
+		}
+		hashedPassword = hashed
+	}
+
+	ps.Lock()
+	var snapshot *accountSnapshot
+	if account, exists, err := ps.getAccount(req.Username); err != nil {
+		ps.Unlock()
+		http.Error(w, "couldn't load the practice account", http.StatusInternalServerError)
+		return
+	} else if !exists {
+		ps.accounts[req.Username] = &practiceAccount{Password: hashedPassword, Attempts: []PracticeAttempt{}}
+		snapshot = ps.snapshot(req.Username)
+	} else if account.Password != hashedPassword && !CheckPasswordHash(&req.Password, account.Password) {
+		// Someone else created the account while the password was being hashed
+		ps.Unlock()
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	ps.prune()
+
+	token := uuid.NewString()
+	ps.tokens[token] = practiceToken{req.Username, time.Now()}
+	ps.Unlock()
+
+	if snapshot != nil {
+		ps.saveAccount(snapshot)
+	}
+
+	type response struct {
+		Token string `json:"token"`
+	}
+	writePracticeJSON(w, response{token})
+}
+
+type practiceProblem struct {
+	Latex       string `json:"latex"`
+	Description string `json:"description"`
+	Title       string `json:"title"`
+}
+
+func toPracticeProblem(problem *Problem) practiceProblem {
+	return practiceProblem{problem.GetLatex(), problem.GetDescription(), problem.GetTitle()}
+}
+
+// startHandler starts a practice session, going through the problem bank in a random order
+func (ps *PracticeStore) startHandler(w http.ResponseWriter, r *http.Request) {
+	enableCors(&w)
+	type request struct {
+		Token string `json:"token"`
+	}
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, PRACTICE_MAX_REQUEST_SIZE)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if len(GetProblems()) == 0 {
+		http.Error(w, "there aren't any problems to practise", http.StatusInternalServerError)
+		return
+	}
+
+	ps.Lock()
+	defer ps.Unlock()
+	name, ok := ps.userFor(req.Token)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	id := uuid.NewString()
+	session := &practiceSession{name: name, order: rand.Perm(len(GetProblems())), startedAt: time.Now(), lastUsed: time.Now()}
+	ps.sessions[id] = session
+
+	type response struct {
+		SessionId string          `json:"sessionId"`
+		Problem   practiceProblem `json:"problem"`
+	}
+	writePracticeJSON(w, response{id, toPracticeProblem(session.problem())})
+}
+
+type practiceSessionRequest struct {
+	Token     string `json:"token"`
+	SessionId string `json:"sessionId"`
+	Answer    string `json:"answer"`
+}
+
+// decodeSessionRequest reads a request about a session, which is done before the store's locked
+func decodeSessionRequest(w http.ResponseWriter, r *http.Request) (*practiceSessionRequest, bool) {
+	var req practiceSessionRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, PRACTICE_MAX_REQUEST_SIZE)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	return &req, true
+}
+
+// getSession finds the session a request's about, checking that it belongs to whoever's logged in
+// @dev Requires the store lock to be held
+func (ps *PracticeStore) getSession(w http.ResponseWriter, req *practiceSessionRequest) (*practiceSession, bool) {
+	name, ok := ps.userFor(req.Token)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return nil, false
+	}
+	session, ok := ps.sessions[req.SessionId]
+	if !ok || session.name != name {
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+	session.lastUsed = time.Now()
+	return session, true
+}
+
+// answerHandler judges an answer to the session's current problem, moving on to the next if it's correct
+func (ps *PracticeStore) answerHandler(w http.ResponseWriter, r *http.Request) {
+	enableCors(&w)
+	req, ok := decodeSessionRequest(w, r)
+	if !ok {
+		return
+	} else if len(req.Answer) > PLAYER_MAX_MESSAGE_SIZE {
+		http.Error(w, "answer is too long", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	type response struct {
+		Correct bool `json:"correct"`
+		// The next problem, if the answer was correct
+		Problem *practiceProblem `json:"problem,omitempty"`
+	}
+	ps.Lock()
+	session, ok := ps.getSession(w, req)
+	if !ok {
+		ps.Unlock()
+		return
+	}
+	correct := session.problem().CheckAnswer(req.Answer, PRACTICE_JUDGE_MODE)
+	snapshot, err := ps.record(session, correct, false)
+	if err != nil {
+		ps.Unlock()
+		http.Error(w, "couldn't load the practice history", http.StatusInternalServerError)
+		return
+	}
+	resp := response{Correct: correct}
+	if correct {
+		session.next()
+		problem := toPracticeProblem(session.problem())
+		resp.Problem = &problem
+	}
+	ps.Unlock()
+
+	ps.saveAccount(snapshot)
+	writePracticeJSON(w, resp)
+}
+
+// skipHandler gives up on the session's current problem, moving on to the next
+func (ps *PracticeStore) skipHandler(w http.ResponseWriter, r *http.Request) {
+	enableCors(&w)
+	req, ok := decodeSessionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ps.Lock()
+	session, ok := ps.getSession(w, req)
+	if !ok {
+		ps.Unlock()
+		return
+	}
+	snapshot, err := ps.record(session, false, true)
+	if err != nil {
+		ps.Unlock()
+		http.Error(w, "couldn't load the practice history", http.StatusInternalServerError)
+		return
+	}
+	session.next()
+	problem := toPracticeProblem(session.problem())
+	ps.Unlock()
+
+	ps.saveAccount(snapshot)
+
+	type response struct {
+		Problem practiceProblem `json:"problem"`
+	}
+	writePracticeJSON(w, response{problem})
+}
+
+// historyHandler gives every practice attempt the user's made, along with how they've done week by week
+func (ps *PracticeStore) historyHandler(w http.ResponseWriter, r *http.Request) {
+	enableCors(&w)
+	type request struct {
+		Token string `json:"token"`
+	}
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, PRACTICE_MAX_REQUEST_SIZE)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ps.Lock()
+	defer ps.Unlock()
+	name, ok := ps.userFor(req.Token)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	account, _, err := ps.getAccount(name)
+	if err != nil {
+		http.Error(w, "couldn't load the practice history", http.StatusInternalServerError)
+		return
+	}
+
+	type response struct {
+		Attempts []PracticeAttempt `json:"attempts"`
+		Weeks    []PracticeWeek    `json:"weeks"`
+	}
+	writePracticeJSON(w, response{account.Attempts, weeklyTrends(account.Attempts)})
+}
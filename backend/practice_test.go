@@ -0,0 +1,81 @@
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestWeekStart(t *testing.T) {
+	// A Sunday evening belongs to the week that started the Monday before
+	sunday := time.Date(2024, time.March, 10, 23, 0, 0, 0, time.UTC)
+	if got, want := weekStart(sunday), time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("weekStart(%v) = %v, want %v", sunday, got, want)
+	}
+	monday := time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)
+	if got := weekStart(monday); !got.Equal(monday) {
+		t.Errorf("weekStart(%v) = %v, want %v", monday, got, monday)
+	}
+}
+
+func TestWeeklyTrends(t *testing.T) {
+	firstWeek := time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	secondWeek := firstWeek.Add(7 * 24 * time.Hour)
+	attempts := []PracticeAttempt{
+		{Title: "a", Correct: false, Seconds: 5, At: secondWeek},
+		{Title: "a", Correct: true, Seconds: 10, At: secondWeek},
+		{Title: "b", Skipped: true, Seconds: 3, At: secondWeek},
+		{Title: "c", Correct: true, Seconds: 20, At: firstWeek},
+	}
+
+	weeks := weeklyTrends(attempts)
+	if len(weeks) != 2 {
+		t.Fatalf("expected 2 weeks, got %d", len(weeks))
+	}
+	if !weeks[0].Start.Before(weeks[1].Start) {
+		t.Error("weeks should be oldest first")
+	}
+
+	first, second := weeks[0], weeks[1]
+	if first.Attempts != 1 || first.Solved != 1 || first.Accuracy != 1 || first.AverageSeconds != 20 {
+		t.Errorf("unexpected first week %+v", first)
+	}
+	// Skips count as attempts, but not towards accuracy
+	if second.Attempts != 3 || second.Solved != 1 || second.Accuracy != 0.5 || second.AverageSeconds != 10 {
+		t.Errorf("unexpected second week %+v", second)
+	}
+}
+
+func TestPracticeStore_GetAccount(t *testing.T) {
+	ps := NewPracticeStore(t.TempDir())
+	if account, exists, err := ps.getAccount("alice"); account != nil || exists || err != nil {
+		t.Errorf("expected a missing account to be reported as new, got %v, %v, %v", account, exists, err)
+	}
+
+	// A history that can't be read mustn't be mistaken for a new account, which would overwrite it
+	if err := ioutil.WriteFile(ps.accountPath("bob"), []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists, err := ps.getAccount("bob"); exists || err == nil {
+		t.Errorf("expected an error loading a corrupt account, got %v, %v", exists, err)
+	}
+}
+
+func TestPracticeStore_SaveAccount(t *testing.T) {
+	ps := NewPracticeStore(t.TempDir())
+	ps.accounts["alice"] = &practiceAccount{Attempts: []PracticeAttempt{}}
+	older := ps.snapshot("alice")
+	ps.accounts["alice"].Attempts = append(ps.accounts["alice"].Attempts, PracticeAttempt{Title: "a"})
+	newer := ps.snapshot("alice")
+
+	// The snapshots can be saved in either order once the store lock's released
+	ps.saveAccount(newer)
+	ps.saveAccount(older)
+
+	account, exists, err := NewPracticeStore(ps.dir).getAccount("alice")
+	if !exists || err != nil {
+		t.Fatalf("expected the account to have been saved, got %v, %v", exists, err)
+	} else if len(account.Attempts) != 1 {
+		t.Errorf("expected the newer snapshot to have been kept, got %d attempts", len(account.Attempts))
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import "time"
+
+// How long a disconnected player has to reconnect before they count as having left, unless the owner changes it
+const DEFAULT_LEAVE_AFTER = time.Minute
+const MAX_LEAVE_AFTER = 30 * time.Minute
+
+func (l *Lobby) leaveAfter() time.Duration {
+	return time.Duration(l.settings.GetLeaveAfter().GetSeconds()) * time.Second
+}
+
+// isPresent is true while the player's connected, or has disconnected but could still come back before
+// they count as having left
+func (l *Lobby) isPresent(name string) bool {
+	_, mightComeBack := l.leaveTimers[name]
+	return l.isConnected(name) || mightComeBack
+}
+
+// playerConnected lets everyone know the player's connected, if this is their first client to be
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) playerConnected(name string) {
+	connections := 0
+	for client := range lobby.clients {
+		if client.name == name && !client.spectator {
+			connections++
+		}
+	}
+	if connections != 1 {
+		return
+	}
+
+	lobby.stopLeaveTimer(name)
+	user := lobby.userMapping[name]
+	user.left = false
+	lobby.userMapping[name] = user
+	lobby.broadcast(lobby.memberUpdate(name))
+}
+
+// playerDisconnected lets everyone know the player's disconnected, if that was their last client, and gives
+// them the grace period to come back before they count as having left
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) playerDisconnected(name string) {
+	if lobby.isConnected(name) || lobby.gameState == Expired {
+		return
+	}
+	lobby.broadcast(lobby.memberUpdate(name))
+
+	lobby.stopLeaveTimer(name)
+	var timer *time.Timer
+	timer = time.AfterFunc(lobby.leaveAfter(), func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		// The player may have come back just as the timer fired
+		if lobby.leaveTimers[name] != timer || lobby.isConnected(name) {
+			return
+		}
+		delete(lobby.leaveTimers, name)
+
+		lobby.markLeft(name)
+		lobby.broadcast(lobby.memberUpdate(name))
+		// Before the game, players that have left are taken off the member list
+		if lobby.gameState == WaitingForPlayers {
+			lobby.broadcast(&ServerSent_Remove{Remove: &ServerSent_RemoveMember{Name: &name}})
+		}
+		// Whoever's left might all have been waiting on them
+		lobby.finishIfEveryoneDone()
+	})
+	lobby.leaveTimers[name] = timer
+}
+
+// markLeft counts the player as having left the lobby
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) markLeft(name string) {
+	lobby.stopLeaveTimer(name)
+	if user, isMember := lobby.userMapping[name]; isMember {
+		user.left = true
+		lobby.userMapping[name] = user
+	}
+}
+
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) stopLeaveTimer(name string) {
+	if timer, ok := lobby.leaveTimers[name]; ok {
+		timer.Stop()
+		delete(lobby.leaveTimers, name)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// How many drafts a player can send at once, and how often they can send another after that
+const DRAFT_BURST = 4
+const DRAFT_INTERVAL = 250 * time.Millisecond
+
+// How much of a problem's solution a draft is compared against; drafts are judged under the lobby lock,
+// so this keeps that quick however long the problem is
+const PROGRESS_MAX_SOLUTION_LENGTH = 1024
+
+// currentProblem is the problem the player's working on, if they're on one
+func (lobby *Lobby) currentProblem(name string) (*Problem, bool) {
+	if lobby.isRace() {
+		if !lobby.round.open || lobby.hasFinishedRound(name) {
+			return nil, false
+		}
+		return lobby.getNewProblem(lobby.round.number).NewProblem.Problem, true
+	}
+	if lobby.hasRunOutOfProblems(name) {
+		return nil, false
+	}
+	return lobby.getNewProblem(lobby.userMapping[name].questionNumber).NewProblem.Problem, true
+}
+
+// progressPercent is how close the draft is to the solution, from 0 to 100; only the start of a long
+// solution is compared against
+func progressPercent(draft string, solution string) int32 {
+	if runes := []rune(normaliseLatex(solution)); len(runes) > PROGRESS_MAX_SOLUTION_LENGTH {
+		solution = string(runes[:PROGRESS_MAX_SOLUTION_LENGTH])
+	}
+	return int32(math.Round(similarity(draft, solution) * 100))
+}
+
+// draftLimiter gives the player's draft rate limiter, which is shared between all their connections
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) draftLimiter(name string) *rateLimiter {
+	limiter, ok := lobby.draftLimiters[name]
+	if !ok {
+		limiter = newRateLimiter(DRAFT_BURST, DRAFT_INTERVAL)
+		lobby.draftLimiters[name] = limiter
+	}
+	return limiter
+}
+
+// DraftHandler is sent as a player types their answer; everyone else is told how close it is to the
+// solution (but not what it is)
+func DraftHandler(event *ClientSent_Draft, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.inPlay() {
+		return fmt.Errorf("game is not in progress")
+	} else if lobby.isEliminated(c.name) || lobby.isOutOfTime(c.name) {
+		return fmt.Errorf("%s isn't playing any more", c.name)
+	} else if len(event.GetAnswer()) > PLAYER_MAX_MESSAGE_SIZE {
+		// The owner's allowed bigger messages, but not bigger drafts
+		return fmt.Errorf("draft from %s is too long", c.name)
+	} else if !lobby.draftLimiter(c.name).allow(time.Now()) {
+		return fmt.Errorf("%s is sending drafts too quickly", c.name)
+	}
+
+	problem, ok := lobby.currentProblem(c.name)
+	if !ok {
+		return fmt.Errorf("%s isn't on a problem", c.name)
+	}
+
+	name, percent := c.name, progressPercent(event.GetAnswer(), problem.GetLatex())
+	progress := &ServerSent_Progress_{Progress: &ServerSent_Progress{Name: &name, Percent: &percent}}
+	for client := range lobby.clients {
+		if client.name != name {
+			client.sendTransient(progress)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressPercent(t *testing.T) {
+	if got := progressPercent("x^2", "x^2"); got != 100 {
+		t.Errorf("expected a draft matching the solution to be 100%% of the way there, got %d", got)
+	}
+
+	// Only the start of a long solution counts
+	solution := strings.Repeat("x", PROGRESS_MAX_SOLUTION_LENGTH) + strings.Repeat("y", 100*PROGRESS_MAX_SOLUTION_LENGTH)
+	if got := progressPercent(strings.Repeat("x", PLAYER_MAX_MESSAGE_SIZE), solution); got != 50 {
+		t.Errorf("expected the draft to be compared against the start of the solution, got %d%%", got)
+	}
+}
+
+func TestLobby_DraftLimiter(t *testing.T) {
+	lobby := playingLobby(t, "draft-limiter", map[string]User{"alice": {}})
+
+	// A player can't get around the limit by opening more connections
+	now := time.Now()
+	for i := 0; i < DRAFT_BURST; i++ {
+		if !lobby.draftLimiter("alice").allow(now) {
+			t.Fatalf("expected draft %d to be allowed", i+1)
+		}
+	}
+	if lobby.draftLimiter("alice").allow(now) {
+		t.Error("expected the player's drafts to be limited across all their connections")
+	}
+	if !lobby.draftLimiter("bob").allow(now) {
+		t.Error("expected other players to have their own limits")
+	}
+}
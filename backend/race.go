@@ -0,0 +1,222 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Length of the break between race rounds if the owner hasn't picked one, and the longest they can pick
+const DEFAULT_ROUND_INTERMISSION = 5 * time.Second
+const MAX_ROUND_INTERMISSION = 60 * time.Second
+
+// raceRound is the lobby-wide state of a race: everyone is on the same problem, so it's kept here
+// rather than in each User's questionNumber
+type raceRound struct {
+	// index (into CustomOrder) of the round's problem
+	number int32
+	// whether the round's being played, rather than being in the intermission after it
+	open bool
+	// when the round started, and when it (or its intermission) is up, on the game clock; a deadline
+	// of 0 means there isn't one
+	startedAt time.Duration
+	deadline  time.Duration
+	// who's solved the round's problem, in order
+	finishers []string
+}
+
+func (l *Lobby) isRace() bool {
+	return l.settings.GetGameMode() == GameMode_RACE
+}
+
+func (l *Lobby) roundTimeLimit() time.Duration {
+	return time.Duration(l.settings.GetRace().GetRoundTimeLimit().GetSeconds()) * time.Second
+}
+
+func (l *Lobby) roundIntermission() time.Duration {
+	if l.settings.GetRace().GetIntermission() == nil {
+		return DEFAULT_ROUND_INTERMISSION
+	}
+	return time.Duration(l.settings.GetRace().GetIntermission().GetSeconds()) * time.Second
+}
+
+func (l *Lobby) roundStartMessage() *ServerSent_RoundStart_ {
+	number := l.round.number
+	message := &ServerSent_RoundStart{Round: &number, Problem: l.getNewProblem(number).NewProblem.Problem}
+	if l.round.deadline != 0 {
+		message.Remaining = &timestamppb.Timestamp{Seconds: int64((l.round.deadline - l.elapsed()).Seconds())}
+	}
+	return &ServerSent_RoundStart_{RoundStart: message}
+}
+
+// startRound puts everyone onto the problem for the given round, or ends the game if there isn't one
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) startRound(number int32) {
+	if number >= int32(len(lobby.CustomOrder)) {
+		lobby.finishGame("Ran out of problems!")
+		return
+	}
+
+	now := lobby.elapsed()
+	lobby.round = raceRound{number: number, open: true, startedAt: now}
+	if limit := lobby.roundTimeLimit(); limit > 0 {
+		lobby.round.deadline = now + limit
+	}
+
+	lobby.broadcast(lobby.roundStartMessage())
+	lobby.armRoundTimer()
+}
+
+// endRound tells everyone who solved the round's problem, then starts the next round after the intermission
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) endRound() {
+	number := lobby.round.number
+	message := &ServerSent_RoundEnd{Round: &number, Intermission: &timestamppb.Timestamp{Seconds: int64(lobby.roundIntermission().Seconds())}}
+	for i, name := range lobby.round.finishers {
+		name, position, points := name, int32(i+1), lobby.handicapped(name, lobby.positionPoints(i))
+		message.Finishers = append(message.Finishers, &ServerSent_RoundEnd_Finisher{Name: &name, Position: &position, Points: &points})
+	}
+
+	lobby.round.open = false
+	lobby.broadcast(&ServerSent_RoundEnd_{RoundEnd: message})
+
+	if lobby.eliminatesEachRound() {
+		lobby.eliminateLowest()
+		// That might've left a winner
+		if !lobby.inPlay() {
+			return
+		}
+	}
+
+	if number+1 >= int32(len(lobby.CustomOrder)) {
+		lobby.finishGame("Ran out of problems!")
+		return
+	} else if lobby.roundIntermission() == 0 {
+		lobby.startRound(number + 1)
+		return
+	}
+
+	lobby.round.deadline = lobby.elapsed() + lobby.roundIntermission()
+	lobby.armRoundTimer()
+}
+
+// armRoundTimer ends the round (or its intermission) once its deadline is reached on the game clock
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) armRoundTimer() {
+	lobby.stopRoundTimer()
+	if lobby.round.deadline == 0 {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(lobby.round.deadline-lobby.elapsed(), func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		// The timer may have fired just as the round ended some other way (or the game was paused)
+		if lobby.roundTimer != timer || !lobby.inPlay() {
+			return
+		}
+
+		if lobby.round.open {
+			lobby.endRound()
+		} else {
+			lobby.startRound(lobby.round.number + 1)
+		}
+	})
+	lobby.roundTimer = timer
+}
+
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) stopRoundTimer() {
+	if lobby.roundTimer != nil {
+		lobby.roundTimer.Stop()
+		lobby.roundTimer = nil
+	}
+}
+
+// positionPoints is how many points the player finishing in the given (0-indexed) position gets
+func (l *Lobby) positionPoints(position int) int32 {
+	points := l.settings.GetRace().GetPositionPoints()
+	if len(points) == 0 {
+		return l.pointsFor(l.getNewProblem(l.round.number).NewProblem.Problem)
+	}
+	return points[position]
+}
+
+// positionsLeft is true while there are still points to be had for solving the round's problem
+func (l *Lobby) positionsLeft() bool {
+	points := l.settings.GetRace().GetPositionPoints()
+	if len(points) == 0 {
+		return len(l.round.finishers) == 0
+	}
+	return len(l.round.finishers) < len(points)
+}
+
+func (l *Lobby) hasFinishedRound(name string) bool {
+	for _, finisher := range l.round.finishers {
+		if finisher == name {
+			return true
+		}
+	}
+	return false
+}
+
+// endRoundIfEveryoneDone ends the round once there's nothing left to win, or every player that's still around has solved it
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) endRoundIfEveryoneDone() {
+	if !lobby.inPlay() || !lobby.round.open {
+		return
+	}
+	if !lobby.positionsLeft() {
+		lobby.endRound()
+		return
+	}
+	anyPlayers := false
+	for name := range lobby.userMapping {
+		if !lobby.isPresent(name) || lobby.isEliminated(name) || lobby.isOutOfTime(name) {
+			continue
+		}
+		if !lobby.hasFinishedRound(name) {
+			return
+		}
+		anyPlayers = true
+	}
+	if anyPlayers {
+		lobby.endRound()
+	}
+}
+
+// raceAnswer is GiveAnswerHandler for race mode, where the answer is for the lobby's current round
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) raceAnswer(c *Client, answer string) error {
+	if !lobby.round.open {
+		return fmt.Errorf("round %d is over", lobby.round.number)
+	} else if lobby.hasFinishedRound(c.name) {
+		return fmt.Errorf("%s has already solved round %d", c.name, lobby.round.number)
+	} else if lobby.isOutOfTime(c.name) {
+		return fmt.Errorf("%s has run out of time", c.name)
+	}
+	problem := lobby.getNewProblem(lobby.round.number).NewProblem.Problem
+
+	if !problem.CheckAnswer(answer, lobby.settings.GetJudgeMode()) {
+		c.send(&ServerSent_Wrong{})
+		lobby.suddenDeath(c.name)
+		return fmt.Errorf("bad payload in request")
+	}
+
+	user := lobby.userMapping[c.name]
+	user.solves = append(user.solves, SolvedProblem{*problem.Title, (lobby.elapsed() - lobby.round.startedAt).Seconds()})
+	user.score += lobby.handicapped(c.name, lobby.positionPoints(len(lobby.round.finishers)))
+	lobby.userMapping[c.name] = user
+	lobby.round.finishers = append(lobby.round.finishers, c.name)
+
+	lobby.broadcast(lobby.scoreUpdate(c.name))
+	if lobby.checkWin(c.name) {
+		return nil
+	}
+	lobby.endRoundIfEveryoneDone()
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// racingLobby builds a race between the users, with no break between rounds, partway through its first round
+func racingLobby(t *testing.T, id string, users map[string]User) (*Lobby, map[string]*Client) {
+	lobby := playingLobby(t, id, users)
+	mode, judgeMode := GameMode_RACE, JudgeMode_EXACT
+	lobby.settings.GameMode = &mode
+	lobby.settings.JudgeMode = &judgeMode
+	lobby.settings.Race = &RaceSettings{PositionPoints: []int32{3, 1}, Intermission: &timestamppb.Timestamp{}}
+	lobby.useCustom = true
+	lobby.CustomProblems = []*Problem{
+		{Title: proto.String("One"), Description: proto.String("One"), Latex: proto.String("x^2")},
+		{Title: proto.String("Two"), Description: proto.String("Two"), Latex: proto.String("y^2")},
+	}
+	lobby.round = raceRound{open: true}
+
+	clients := map[string]*Client{}
+	for client := range lobby.clients {
+		clients[client.name] = client
+	}
+	return lobby, clients
+}
+
+func TestLobby_RaceAnswer(t *testing.T) {
+	lobby, clients := racingLobby(t, "race-answer", map[string]User{"alice": {}, "bob": {}, "carol": {}})
+
+	if err := lobby.raceAnswer(clients["carol"], "z^2"); err == nil {
+		t.Errorf("expected a wrong answer to be refused")
+	}
+	if err := lobby.raceAnswer(clients["bob"], "x ^ 2"); err != nil {
+		t.Fatalf("expected bob's answer to be accepted, got %v", err)
+	}
+	if err := lobby.raceAnswer(clients["bob"], "x^2"); err == nil {
+		t.Errorf("expected bob not to be able to solve the round twice")
+	}
+	if !lobby.round.open || lobby.round.number != 0 {
+		t.Fatalf("expected the round to go on while there are points left")
+	}
+
+	// Carol takes the last of the points, which ends the round and (with no intermission) starts the next
+	if err := lobby.raceAnswer(clients["carol"], "x^2"); err != nil {
+		t.Fatalf("expected carol's answer to be accepted, got %v", err)
+	}
+	if !lobby.round.open || lobby.round.number != 1 || len(lobby.round.finishers) != 0 {
+		t.Errorf("expected the second round to have started, got %+v", lobby.round)
+	}
+	for name, score := range map[string]int32{"alice": 0, "bob": 3, "carol": 1} {
+		if got := lobby.userMapping[name].score; got != score {
+			t.Errorf("expected %s to have %d points, got %d", name, score, got)
+		}
+	}
+	if err := lobby.raceAnswer(clients["alice"], "x^2"); err == nil {
+		t.Errorf("expected an answer to the last round's problem to be refused")
+	}
+}
+
+func TestLobby_RaceLastRound(t *testing.T) {
+	lobby, clients := racingLobby(t, "race-last-round", map[string]User{"alice": {}, "bob": {}})
+	lobby.round = raceRound{number: 1, open: true}
+
+	lobby.raceAnswer(clients["alice"], "y^2")
+	if lobby.gameState != InPlay {
+		t.Fatalf("expected the game to go on while bob can still score, but it's %s", lobby.gameState)
+	}
+	lobby.raceAnswer(clients["bob"], "y^2")
+	if lobby.gameState != Finished {
+		t.Errorf("expected the game to be finished after the last round, but it's %s", lobby.gameState)
+	}
+}
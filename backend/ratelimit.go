@@ -0,0 +1,32 @@
+package main
+
+import "time"
+
+// rateLimiter is a token bucket: it allows bursts of up to burst events, refilling one every interval
+type rateLimiter struct {
+	burst    int
+	interval time.Duration
+	tokens   float64
+	last     time.Time
+}
+
+func newRateLimiter(burst int, interval time.Duration) *rateLimiter {
+	return &rateLimiter{burst: burst, interval: interval, tokens: float64(burst)}
+}
+
+// allow is true if an event happening now is within the limit, using up a token if so
+func (rl *rateLimiter) allow(now time.Time) bool {
+	if !rl.last.IsZero() {
+		rl.tokens += float64(now.Sub(rl.last)) / float64(rl.interval)
+		if rl.tokens > float64(rl.burst) {
+			rl.tokens = float64(rl.burst)
+		}
+	}
+	rl.last = now
+
+	if rl.tokens < 1 {
+		return false
+	}
+	rl.tokens--
+	return true
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(2, time.Second)
+	now := time.Now()
+
+	if !rl.allow(now) || !rl.allow(now) {
+		t.Fatal("a burst of up to 2 should be allowed")
+	}
+	if rl.allow(now) {
+		t.Error("a third event straight away should be limited")
+	}
+	if rl.allow(now.Add(500 * time.Millisecond)) {
+		t.Error("half an interval shouldn't be enough for another event")
+	}
+	if !rl.allow(now.Add(time.Second)) {
+		t.Error("an event should be allowed once an interval has passed")
+	}
+	// However long it's been, no more than a burst's worth is allowed at once
+	later := now.Add(time.Hour)
+	if !rl.allow(later) || !rl.allow(later) || rl.allow(later) {
+		t.Error("the bucket should only refill up to the burst size")
+	}
+}
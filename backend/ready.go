@@ -0,0 +1,61 @@
+package main
+
+import "fmt"
+
+// readyCount is how many of the connected players (other than the owner) are ready, out of how many there are
+func (lobby *Lobby) readyCount() (int, int) {
+	players := make(map[string]bool)
+	for client := range lobby.clients {
+		if !client.spectator && !lobby.isOwner(client.name) {
+			players[client.name] = true
+		}
+	}
+
+	ready := 0
+	for name := range players {
+		if lobby.userMapping[name].ready {
+			ready++
+		}
+	}
+	return ready, len(players)
+}
+
+// checkReady errors if not enough players are ready for the game to start
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) checkReady() error {
+	ready, players := lobby.readyCount()
+
+	switch lobby.settings.GetReadyRequirement() {
+	case ReadyRequirement_ALL_READY:
+		if ready < players {
+			return fmt.Errorf("only %d of %d players are ready", ready, players)
+		}
+	case ReadyRequirement_QUORUM_READY:
+		quorum := lobby.settings.GetReadyQuorum()
+		if quorum == 0 {
+			// A majority
+			if ready*2 <= players {
+				return fmt.Errorf("only %d of %d players are ready, more than half need to be", ready, players)
+			}
+		} else if ready*100 < int(quorum)*players {
+			return fmt.Errorf("only %d of %d players are ready, %d%% need to be", ready, players, quorum)
+		}
+	}
+	return nil
+}
+
+// SetReadyHandler is sent by a player to say whether they're ready for the game to start
+func SetReadyHandler(event *ClientSent_SetReady, c *Client) error {
+	lobby := c.lobby
+
+	if lobby.gameState != WaitingForPlayers {
+		return fmt.Errorf("players can only get ready while waiting for the game to start")
+	}
+
+	user := lobby.userMapping[c.name]
+	user.ready = event.GetReady()
+	lobby.userMapping[c.name] = user
+
+	lobby.broadcast(lobby.memberUpdate(c.name))
+	return nil
+}
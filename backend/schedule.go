@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The furthest ahead a game can be scheduled to start
+const MAX_SCHEDULE_AHEAD = 7 * 24 * time.Hour
+
+// How long before a scheduled start everyone's reminded of it (the pre-game countdown takes over after)
+var scheduleNotices = []time.Duration{10 * time.Minute, 5 * time.Minute, time.Minute, 30 * time.Second, 10 * time.Second}
+
+func (l *Lobby) scheduledMessage() *ServerSent_Scheduled_ {
+	message := &ServerSent_Scheduled{ServerTime: timestamppb.Now()}
+	if l.scheduledStart != nil {
+		message.StartTime = timestamppb.New(*l.scheduledStart)
+	}
+	return &ServerSent_Scheduled_{Scheduled: message}
+}
+
+// schedule has the game start by itself at the given time, even if the owner isn't around
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) schedule(startTime time.Time) error {
+	if lobby.gameState != WaitingForPlayers {
+		return fmt.Errorf("a start can only be scheduled while waiting for players")
+	} else if !startTime.After(time.Now()) {
+		return fmt.Errorf("the scheduled start has to be in the future")
+	} else if time.Until(startTime) > MAX_SCHEDULE_AHEAD {
+		return fmt.Errorf("the start can be scheduled at most %v ahead", MAX_SCHEDULE_AHEAD)
+	}
+
+	lobby.scheduledStart = &startTime
+	lobby.armScheduleTimer()
+	return nil
+}
+
+// armScheduleTimer wakes up for the next reminder before the scheduled start, or to start the game once
+// it's time for the pre-game countdown
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) armScheduleTimer() {
+	if lobby.scheduleTimer != nil {
+		lobby.scheduleTimer.Stop()
+	}
+
+	countdownAt := lobby.scheduledStart.Add(-lobby.countdown())
+	wakeAt := countdownAt
+	for _, notice := range scheduleNotices {
+		if noticeAt := lobby.scheduledStart.Add(-notice); noticeAt.After(time.Now()) && noticeAt.Before(wakeAt) {
+			wakeAt = noticeAt
+			break
+		}
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Until(wakeAt), func() {
+		lobby.Lock()
+		defer lobby.Unlock()
+
+		// The schedule may have been changed (or cancelled) just as the timer fired
+		if lobby.scheduleTimer != timer || lobby.gameState != WaitingForPlayers {
+			return
+		}
+
+		// The countdown setting might have changed since the timer was armed
+		if !time.Now().Before(lobby.scheduledStart.Add(-lobby.countdown())) {
+			if err := lobby.start(&ClientSent_RequestStart{Force: proto.Bool(true)}); err != nil {
+				log.Printf("Failed to start lobby %s on schedule: %v\n", lobby.id, err)
+				lobby.stopSchedule()
+				lobby.broadcast(lobby.scheduledMessage())
+			}
+			return
+		}
+
+		lobby.broadcast(lobby.scheduledMessage())
+		lobby.armScheduleTimer()
+	})
+	lobby.scheduleTimer = timer
+}
+
+// stopSchedule cancels the scheduled start, if there is one
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) stopSchedule() {
+	if lobby.scheduleTimer != nil {
+		lobby.scheduleTimer.Stop()
+		lobby.scheduleTimer = nil
+	}
+	lobby.scheduledStart = nil
+}
+
+// ScheduleStartHandler is sent by the owner to schedule (or cancel) the game starting by itself
+func ScheduleStartHandler(event *ClientSent_ScheduleStart, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can schedule the start")
+	}
+
+	if event.StartTime == nil {
+		lobby.stopSchedule()
+	} else if err := lobby.schedule(event.GetStartTime().AsTime()); err != nil {
+		c.sendError(err)
+		return err
+	}
+
+	lobby.broadcast(lobby.scheduledMessage())
+	return nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// How many of the most recent messages a session keeps, to replay to its client after a reconnect
+const SESSION_OUTBOX_SIZE = 256
+
+// How long after being disconnected a client can still resume its session
+const SESSION_RESUME_WINDOW = 2 * time.Minute
+
+// Close code sent when a reconnecting client turns out to be unable to resume its session after all
+const CLOSE_RESUME_FAILED = 4003
+
+var errResumeTooLate = errors.New("too many messages were missed to resume the session")
+
+// session outlives a client's connection, so the client can pick up where it left off after reconnecting
+type session struct {
+	token     string
+	name      string
+	spectator bool
+	// the client connected to the session, or when it was disconnected if there isn't one
+	client         *Client
+	disconnectedAt time.Time
+	// who the (spectating) client was following when it was disconnected
+	following string
+
+	// seq is the number of the last message sent in the session, and outbox holds the most recent of them
+	seq    uint64
+	outbox [][]byte
+}
+
+func newSession(name string, spectator bool) *session {
+	return &session{token: uuid.NewString(), name: name, spectator: spectator}
+}
+
+// record numbers the message and keeps it for replaying, giving back what's to be written to the client
+// @dev Requires the lobby lock to be held
+func (s *session) record(message isServerSent_Message) []byte {
+	s.seq++
+	data := protofy(message, s.seq)
+
+	s.outbox = append(s.outbox, data)
+	if len(s.outbox) > SESSION_OUTBOX_SIZE {
+		s.outbox = s.outbox[len(s.outbox)-SESSION_OUTBOX_SIZE:]
+	}
+	return data
+}
+
+// missedSince gives the messages sent after seq, erroring if some of them are no longer kept
+func (s *session) missedSince(seq uint64) ([][]byte, error) {
+	if seq > s.seq {
+		return nil, errors.New("the session hasn't got that far")
+	}
+	missed := s.seq - seq
+	if missed > uint64(len(s.outbox)) {
+		return nil, errResumeTooLate
+	}
+	return s.outbox[len(s.outbox)-int(missed):], nil
+}
+
+func (s *session) message(resumed bool) []byte {
+	data, err := proto.Marshal(&ServerSent{Message: &ServerSent_Session_{Session: &ServerSent_Session{Token: &s.token, Resumed: &resumed}}})
+	if err != nil {
+		log.Println(err)
+	}
+	return data
+}
+
+// resumableSession finds the session a reconnecting client wants to resume, checking it can be
+// @dev Requires the lobby lock to be held
+func (l *Lobby) resumableSession(token string, seq uint64, ip string) (*session, error) {
+	s, ok := l.sessions[token]
+	if !ok || (s.client == nil && time.Since(s.disconnectedAt) > SESSION_RESUME_WINDOW) {
+		return nil, errors.New("no session to resume")
+	} else if !s.spectator && l.isBanned(s.name, ip) {
+		return nil, errors.New("banned from this lobby")
+	}
+	if _, err := s.missedSince(seq); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// resumeClient connects the client to its session, replaying the messages it missed before anything new
+func (lobby *Lobby) resumeClient(client *Client, seq uint64) error {
+	lobby.Lock()
+	defer lobby.Unlock()
+
+	s := client.session
+	missed, err := s.missedSince(seq)
+	if err != nil {
+		return err
+	}
+	// A connection that's gone quiet without closing is replaced
+	if s.client != nil {
+		lobby.dropClient(s.client)
+	}
+	lobby.attachClient(client)
+	client.following = s.following
+
+	client.deliver(s.message(true))
+	for _, data := range missed {
+		client.deliver(data)
+	}
+	client.send(lobby.snapshot())
+	if !client.spectator {
+		lobby.playerConnected(client.name)
+	}
+	return nil
+}
+
+// disconnectedSessions are the sessions that can be resumed, which keep what would've been sent to them
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) disconnectedSessions() []*session {
+	var sessions []*session
+	for _, s := range lobby.sessions {
+		if s.client == nil {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions
+}
+
+// endSessions stops anyone resuming the name's sessions, like when they're removed from the lobby
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) endSessions(name string) {
+	for token, s := range lobby.sessions {
+		if s.name == name {
+			delete(lobby.sessions, token)
+		}
+	}
+}
+
+// pruneSessions forgets about the sessions that can no longer be resumed
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) pruneSessions(now time.Time) {
+	for token, s := range lobby.sessions {
+		if s.client == nil && now.Sub(s.disconnectedAt) > SESSION_RESUME_WINDOW {
+			delete(lobby.sessions, token)
+		}
+	}
+}
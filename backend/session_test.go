@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestSessionOutbox(t *testing.T) {
+	s := newSession("alice", false)
+	for i := 0; i < SESSION_OUTBOX_SIZE+10; i++ {
+		s.record(&ServerSent_Rematch_{Rematch: &ServerSent_Rematch{}})
+	}
+
+	missed, err := s.missedSince(s.seq - 3)
+	if err != nil || len(missed) != 3 {
+		t.Fatalf("expected the last 3 messages, got %d (%v)", len(missed), err)
+	}
+	if missed, err := s.missedSince(s.seq); err != nil || len(missed) != 0 {
+		t.Errorf("nothing should've been missed, got %d (%v)", len(missed), err)
+	}
+	if _, err := s.missedSince(5); err != errResumeTooLate {
+		t.Errorf("messages that were dropped from the outbox can't be replayed, got %v", err)
+	}
+	if _, err := s.missedSince(s.seq + 1); err == nil {
+		t.Error("a seq that hasn't been sent yet can't be resumed from")
+	}
+}
+
+func TestSessionSkipsTransientMessages(t *testing.T) {
+	lobby := playingLobby(t, "session-transient", map[string]User{"alice": {}})
+	for client := range lobby.clients {
+		for i := 0; i < SESSION_OUTBOX_SIZE+10; i++ {
+			client.sendTransient(&ServerSent_Progress_{Progress: &ServerSent_Progress{}})
+		}
+		if client.session.seq != 0 || len(client.session.outbox) != 0 {
+			t.Errorf("transient messages shouldn't be kept for replaying, got %d kept", len(client.session.outbox))
+		}
+		if _, err := client.session.missedSince(0); err != nil {
+			t.Errorf("the session should still be resumable, got %v", err)
+		}
+	}
+}
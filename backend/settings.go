@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Bounds on the settings an owner can pick
+const MIN_GAME_DURATION = 10 * time.Second
+const MAX_GAME_DURATION = 3 * time.Hour
+const MAX_PLAYERS = 100
+
+func defaultSettings() *LobbySettings {
+	return &LobbySettings{
+		Duration:   &timestamppb.Timestamp{Seconds: 600},
+		Countdown:  &timestamppb.Timestamp{Seconds: int64(TIME_TO_START_GAME.Seconds())},
+		LeaveAfter: &timestamppb.Timestamp{Seconds: int64(DEFAULT_LEAVE_AFTER.Seconds())},
+	}
+}
+
+func (l *Lobby) duration() time.Duration {
+	return time.Duration(l.settings.GetDuration().GetSeconds()) * time.Second
+}
+
+// hasTimeLimit is false if the game only ends once someone meets the win condition
+func (l *Lobby) hasTimeLimit() bool {
+	return l.duration() > 0
+}
+
+func (l *Lobby) countdown() time.Duration {
+	return time.Duration(l.settings.GetCountdown().GetSeconds()) * time.Second
+}
+
+func (l *Lobby) settingsMessage() *ServerSent_Settings_ {
+	return &ServerSent_Settings_{Settings: &ServerSent_Settings{Settings: l.settings}}
+}
+
+// updateSettings merges the fields set in the update into the lobby's settings, as long as the result
+// is valid, and lets everyone know about the change
+// @dev Requires the lobby lock to be held
+func (l *Lobby) updateSettings(update *LobbySettings) error {
+	settings := proto.Clone(l.settings).(*LobbySettings)
+	proto.Merge(settings, update)
+	replaceTimestamps(settings, update)
+	replaceRepeated(settings, update)
+
+	if err := l.validateSettings(settings); err != nil {
+		return err
+	}
+
+	l.settings = settings
+	l.broadcast(l.settingsMessage())
+	return nil
+}
+
+// replaceTimestamps copies over the timestamps set in the update, as merging one that's 0 (e.g. no time
+// limit) leaves the old value in place
+func replaceTimestamps(settings *LobbySettings, update *LobbySettings) {
+	if update.Duration != nil {
+		settings.Duration = update.Duration
+	}
+	if update.Countdown != nil {
+		settings.Countdown = update.Countdown
+	}
+	if update.LeaveAfter != nil {
+		settings.LeaveAfter = update.LeaveAfter
+	}
+	if update.GetRace().GetRoundTimeLimit() != nil {
+		settings.Race.RoundTimeLimit = update.Race.RoundTimeLimit
+	}
+	if update.GetRace().GetIntermission() != nil {
+		settings.Race.Intermission = update.Race.Intermission
+	}
+	if update.GetElimination().GetInterval() != nil {
+		settings.Elimination.Interval = update.Elimination.Interval
+	}
+	if update.GetMatch().GetIntermission() != nil {
+		settings.Match.Intermission = update.Match.Intermission
+	}
+}
+
+// replaceRepeated replaces the lists set in the update, which merging would otherwise add on to
+func replaceRepeated(settings *LobbySettings, update *LobbySettings) {
+	if len(update.GetRace().GetPositionPoints()) > 0 {
+		settings.Race.PositionPoints = update.Race.PositionPoints
+	}
+	if len(update.GetMatch().GetRoundSettings()) > 0 {
+		settings.Match.RoundSettings = update.Match.RoundSettings
+	}
+}
+
+func (l *Lobby) validateSettings(settings *LobbySettings) error {
+	win := settings.GetWinCondition()
+	if win.GetTargetSolves() < 0 || win.GetTargetScore() < 0 {
+		return fmt.Errorf("win condition targets can't be negative")
+	}
+	hasWinCondition := win.GetTargetSolves() > 0 || win.GetTargetScore() > 0
+
+	duration := time.Duration(settings.GetDuration().GetSeconds()) * time.Second
+	if duration == 0 && !hasWinCondition {
+		return fmt.Errorf("a game without a time limit needs a win condition")
+	} else if duration != 0 && (duration < MIN_GAME_DURATION || duration > MAX_GAME_DURATION) {
+		return fmt.Errorf("duration must be between %v and %v", MIN_GAME_DURATION, MAX_GAME_DURATION)
+	}
+
+	countdown := time.Duration(settings.GetCountdown().GetSeconds()) * time.Second
+	if countdown < 0 || countdown > MAX_TIME_TO_START_GAME {
+		return fmt.Errorf("countdown must be between 0 and %v", MAX_TIME_TO_START_GAME)
+	}
+
+	maxPlayers := settings.GetMaxPlayers()
+	if maxPlayers < 0 || maxPlayers > MAX_PLAYERS {
+		return fmt.Errorf("max players must be between 0 (no limit) and %d", MAX_PLAYERS)
+	} else if maxPlayers != 0 && int(maxPlayers) < len(l.userMapping) {
+		return fmt.Errorf("there are already more than %d players in the lobby", maxPlayers)
+	}
+
+	race := settings.GetRace()
+	if race.GetRoundTimeLimit().GetSeconds() < 0 {
+		return fmt.Errorf("round time limit can't be negative")
+	} else if intermission := time.Duration(race.GetIntermission().GetSeconds()) * time.Second; intermission < 0 || intermission > MAX_ROUND_INTERMISSION {
+		return fmt.Errorf("intermission must be between 0 and %v", MAX_ROUND_INTERMISSION)
+	} else if len(race.GetPositionPoints()) > MAX_PLAYERS {
+		return fmt.Errorf("points can be given out for at most %d positions", MAX_PLAYERS)
+	}
+	for _, points := range race.GetPositionPoints() {
+		if points < 0 {
+			return fmt.Errorf("position points can't be negative")
+		}
+	}
+
+	if settings.GetReadyQuorum() < 0 || settings.GetReadyQuorum() > 100 {
+		return fmt.Errorf("ready quorum must be a percentage")
+	}
+	if leaveAfter := time.Duration(settings.GetLeaveAfter().GetSeconds()) * time.Second; leaveAfter < 0 || leaveAfter > MAX_LEAVE_AFTER {
+		return fmt.Errorf("leave grace period must be between 0 and %v", MAX_LEAVE_AFTER)
+	}
+	if settings.GetLateJoinAllowance() < 0 || settings.GetLateJoinAllowance() > 100 {
+		return fmt.Errorf("late join allowance must be a percentage")
+	}
+
+	if err := l.validateElimination(settings); err != nil {
+		return err
+	}
+	if err := l.validateMatch(settings); err != nil {
+		return err
+	}
+
+	filter := settings.GetProblemFilter()
+	if filter.GetMinLength() < 0 || filter.GetMaxLength() < 0 {
+		return fmt.Errorf("problem lengths can't be negative")
+	} else if filter.GetMaxLength() != 0 && filter.GetMaxLength() < filter.GetMinLength() {
+		return fmt.Errorf("the longest problem length is shorter than the shortest")
+	} else if len(filterProblems(l.getLobbyProblems(), filter)) == 0 {
+		return fmt.Errorf("no problems match the filter")
+	}
+
+	return nil
+}
+
+// filterProblems gives the indices of the problems that match the filter
+func filterProblems(problems []*Problem, filter *ProblemFilter) []int {
+	search := strings.ToLower(filter.GetSearch())
+	matching := make([]int, 0, len(problems))
+	for i, problem := range problems {
+		length := int32(len(problem.GetLatex()))
+		if length < filter.GetMinLength() || (filter.GetMaxLength() != 0 && length > filter.GetMaxLength()) {
+			continue
+		}
+		if search != "" &&
+			!strings.Contains(strings.ToLower(problem.GetTitle()), search) &&
+			!strings.Contains(strings.ToLower(problem.GetDescription()), search) {
+			continue
+		}
+		matching = append(matching, i)
+	}
+	return matching
+}
+
+// pointsFor is how many points solving the problem is worth under the lobby's scoring mode
+func (l *Lobby) pointsFor(problem *Problem) int32 {
+	switch l.settings.GetScoringMode() {
+	case ScoringMode_FLAT:
+		return 1
+	default:
+		// gainedPoints = ⌈latexSolutionLength / 10⌉
+		return int32(math.Ceil(float64(len(problem.GetLatex())) / float64(10)))
+	}
+}
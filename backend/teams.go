@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+const MAX_TEAMS = 16
+
+func (lobby *Lobby) hasTeams() bool {
+	return len(lobby.teams) > 0
+}
+
+func (lobby *Lobby) isTeam(team string) bool {
+	for _, t := range lobby.teams {
+		if t == team {
+			return true
+		}
+	}
+	return false
+}
+
+// teamMembers gives the (sorted) names of each team's members
+func (lobby *Lobby) teamMembers() map[string][]string {
+	members := make(map[string][]string, len(lobby.teams))
+	for name, user := range lobby.userMapping {
+		if user.team != "" {
+			members[user.team] = append(members[user.team], name)
+		}
+	}
+	for _, names := range members {
+		sort.Strings(names)
+	}
+	return members
+}
+
+// teamScores totals up the scores of each team's members
+func (lobby *Lobby) teamScores() map[string]int32 {
+	scores := make(map[string]int32, len(lobby.teams))
+	for _, user := range lobby.userMapping {
+		if user.team != "" {
+			scores[user.team] += user.score
+		}
+	}
+	return scores
+}
+
+func (lobby *Lobby) teamsMessage() *ServerSent_Teams_ {
+	members := lobby.teamMembers()
+	teams := make([]*Team, 0, len(lobby.teams))
+	for _, name := range lobby.teams {
+		name := name
+		teams = append(teams, &Team{Name: &name, Members: members[name]})
+	}
+	return &ServerSent_Teams_{Teams: &ServerSent_Teams{Teams: teams}}
+}
+
+// scoreUpdate is the score update for a player, along with the team totals if the lobby has teams
+func (lobby *Lobby) scoreUpdate(name string) *ServerSent_ScoreUpdate_ {
+	score := lobby.userMapping[name].score
+	update := &ServerSent_ScoreUpdate{Name: &name, Score: &score}
+
+	scores := lobby.teamScores()
+	for _, team := range lobby.teams {
+		team, teamScore := team, scores[team]
+		update.TeamScores = append(update.TeamScores, &ServerSent_ScoreUpdate_TeamScore{Team: &team, Score: &teamScore})
+	}
+
+	return &ServerSent_ScoreUpdate_{ScoreUpdate: update}
+}
+
+// assignRemainingPlayers puts every player who hasn't picked a team into whichever team is smallest
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) assignRemainingPlayers() {
+	if !lobby.hasTeams() {
+		return
+	}
+
+	sizes := make(map[string]int, len(lobby.teams))
+	unassigned := make([]string, 0)
+	for name, user := range lobby.userMapping {
+		if user.team == "" {
+			unassigned = append(unassigned, name)
+		} else {
+			sizes[user.team]++
+		}
+	}
+	// Sorted, so that who ends up where doesn't depend on map ordering
+	sort.Strings(unassigned)
+
+	for _, name := range unassigned {
+		smallest := lobby.teams[0]
+		for _, team := range lobby.teams {
+			if sizes[team] < sizes[smallest] {
+				smallest = team
+			}
+		}
+		user := lobby.userMapping[name]
+		user.team = smallest
+		lobby.userMapping[name] = user
+		sizes[smallest]++
+	}
+}
+
+// moveTeammatesOn brings the player's teammates onto the same problem as them, if teams share problems
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) moveTeammatesOn(name string) {
+	user := lobby.userMapping[name]
+	if !lobby.settings.GetTeamSharedProblems() || user.team == "" {
+		return
+	}
+
+	for teammateName, teammate := range lobby.userMapping {
+		if teammateName == name || teammate.team != user.team || teammate.eliminated {
+			continue
+		}
+		teammate.questionNumber = user.questionNumber
+		teammate.problemStartedAt = user.problemStartedAt
+		lobby.userMapping[teammateName] = teammate
+
+		for client := range lobby.clients {
+			if client.name != teammateName || client.spectator {
+				continue
+			}
+			if lobby.hasRunOutOfProblems(teammateName) {
+				endGame(client, "Your team ran out of problems!")
+			} else {
+				client.sendClientProblem()
+			}
+		}
+		for _, session := range lobby.disconnectedSessions() {
+			if session.name == teammateName && !session.spectator && !lobby.hasRunOutOfProblems(teammateName) {
+				problem := lobby.getNewProblem(teammate.questionNumber)
+				session.record(&problem)
+			}
+		}
+	}
+}
+
+// DefineTeamsHandler is sent by the owner to set up the lobby's teams before the game starts
+func DefineTeamsHandler(event *ClientSent_DefineTeams, c *Client) error {
+	lobby := c.lobby
+
+	if !lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can define teams")
+	} else if lobby.gameState != WaitingForPlayers {
+		return fmt.Errorf("teams can only be changed while waiting for players")
+	}
+
+	names := event.GetNames()
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name == "" || seen[name] {
+			err := fmt.Errorf("team names have to be non-empty and unique")
+			c.sendError(err)
+			return err
+		}
+		seen[name] = true
+	}
+	if len(names) > MAX_TEAMS {
+		err := fmt.Errorf("there can be at most %d teams", MAX_TEAMS)
+		c.sendError(err)
+		return err
+	}
+
+	lobby.teams = names
+	// Anyone in a team that's gone has to pick again
+	for name, user := range lobby.userMapping {
+		if user.team != "" && !seen[user.team] {
+			user.team = ""
+			lobby.userMapping[name] = user
+		}
+	}
+
+	lobby.broadcast(lobby.teamsMessage())
+	return nil
+}
+
+// JoinTeamHandler is sent by a player to pick their team before the game starts
+func JoinTeamHandler(event *ClientSent_JoinTeam, c *Client) error {
+	return c.lobby.setTeam(c.name, event.GetTeam())
+}
+
+// AssignTeamHandler is sent by the owner to move a player between teams before the game starts
+func AssignTeamHandler(event *ClientSent_AssignTeam, c *Client) error {
+	if !c.lobby.isOwner(c.name) {
+		return fmt.Errorf("only the owner can assign teams")
+	}
+	return c.lobby.setTeam(event.GetName(), event.GetTeam())
+}
+
+// setTeam moves a player into a team, or out of any team if it's empty
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) setTeam(name string, team string) error {
+	if lobby.gameState != WaitingForPlayers {
+		return fmt.Errorf("teams can only be changed while waiting for players")
+	} else if team != "" && !lobby.isTeam(team) {
+		return fmt.Errorf("there's no team called %s", team)
+	}
+	user, isMember := lobby.userMapping[name]
+	if !isMember {
+		return fmt.Errorf("%s isn't a member of the lobby", name)
+	}
+
+	user.team = team
+	lobby.userMapping[name] = user
+
+	lobby.broadcast(lobby.teamsMessage())
+	return nil
+}
@@ -1,6 +1,11 @@
 package main
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"net"
+	"net/http"
+
+	"golang.org/x/crypto/bcrypt"
+)
 
 func HashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), 14)
@@ -14,3 +19,12 @@ func CheckPasswordHash(password *string, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(*password))
 	return err == nil
 }
+
+// requestIP is the address a request came from, without its port
+func requestIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
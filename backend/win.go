@@ -0,0 +1,79 @@
+package main
+
+import "sort"
+
+func (l *Lobby) hasWinCondition() bool {
+	win := l.settings.GetWinCondition()
+	return win.GetTargetSolves() > 0 || win.GetTargetScore() > 0
+}
+
+// isRanked is true if the game's results include the order everyone finished in
+func (l *Lobby) isRanked() bool {
+	return l.eliminationEnabled() || l.hasWinCondition()
+}
+
+// checkWin ends the game if the player has just met the win condition, returning whether they have
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) checkWin(name string) bool {
+	if !lobby.hasWinCondition() {
+		return false
+	}
+	win, user := lobby.settings.GetWinCondition(), lobby.userMapping[name]
+	solved := win.GetTargetSolves() > 0 && int32(len(user.solves)) >= win.GetTargetSolves()
+	scored := win.GetTargetScore() > 0 && user.score >= win.GetTargetScore()
+	if !solved && !scored {
+		return false
+	}
+
+	lobby.winner = name
+	lobby.finishGame(name + " won!")
+	return true
+}
+
+// settleStandings places everyone still in once the game's over: the winner first, then everyone else
+// by how close they got to the win condition (or by score)
+// @dev Requires the lobby lock to be held
+func (lobby *Lobby) settleStandings() {
+	if !lobby.isRanked() {
+		return
+	}
+
+	unplaced := make([]string, 0, len(lobby.userMapping))
+	for name, user := range lobby.userMapping {
+		if user.place == 0 {
+			unplaced = append(unplaced, name)
+		}
+	}
+	bySolves := lobby.settings.GetWinCondition().GetTargetSolves() > 0
+	sort.Slice(unplaced, func(i, j int) bool {
+		a, b := lobby.userMapping[unplaced[i]], lobby.userMapping[unplaced[j]]
+		if (unplaced[i] == lobby.winner) != (unplaced[j] == lobby.winner) {
+			return unplaced[i] == lobby.winner
+		} else if bySolves && len(a.solves) != len(b.solves) {
+			return len(a.solves) > len(b.solves)
+		} else if a.score != b.score {
+			return a.score > b.score
+		}
+		return unplaced[i] < unplaced[j]
+	})
+
+	for i, name := range unplaced {
+		user := lobby.userMapping[name]
+		user.place = int32(i + 1)
+		lobby.userMapping[name] = user
+	}
+}
+
+// standings gives the names of the members in the order they finished, winner first
+func (lobby *Lobby) standings() []string {
+	names := make([]string, 0, len(lobby.userMapping))
+	for name, user := range lobby.userMapping {
+		if user.place != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return lobby.userMapping[names[i]].place < lobby.userMapping[names[j]].place
+	})
+	return names
+}
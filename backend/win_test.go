@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLobby_CheckWin(t *testing.T) {
+	solved := []SolvedProblem{{Title: "one"}, {Title: "two"}}
+	tests := []struct {
+		name  string
+		win   *WinCondition
+		users map[string]User
+		// winner is who should have won, if anyone, and places everyone's place once the game's over
+		winner string
+		places map[string]int32
+	}{
+		{
+			name:   "reaching the target solves",
+			win:    &WinCondition{TargetSolves: proto.Int32(2)},
+			users:  map[string]User{"alice": {solves: solved}, "bob": {solves: solved[:1], score: 9}, "carol": {}},
+			winner: "alice",
+			places: map[string]int32{"alice": 1, "bob": 2, "carol": 3},
+		},
+		{
+			name:   "reaching the target score",
+			win:    &WinCondition{TargetScore: proto.Int32(10)},
+			users:  map[string]User{"alice": {score: 4}, "bob": {score: 12}, "carol": {score: 7}},
+			winner: "bob",
+			places: map[string]int32{"bob": 1, "carol": 2, "alice": 3},
+		},
+		{
+			name:  "short of the target",
+			win:   &WinCondition{TargetScore: proto.Int32(10), TargetSolves: proto.Int32(3)},
+			users: map[string]User{"alice": {solves: solved, score: 9}, "bob": {}},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lobby := playingLobby(t, fmt.Sprintf("win-%d", i), test.users)
+			lobby.settings.WinCondition = test.win
+
+			for name := range test.users {
+				if lobby.checkWin(name) {
+					break
+				}
+			}
+
+			if lobby.winner != test.winner {
+				t.Fatalf("expected %q to win, got %q", test.winner, lobby.winner)
+			} else if test.winner == "" {
+				if lobby.gameState != InPlay {
+					t.Errorf("the game should go on until someone wins, but it's %s", lobby.gameState)
+				}
+				return
+			}
+			if lobby.gameState != Finished {
+				t.Errorf("the game should be over once someone's won, but it's %s", lobby.gameState)
+			}
+			for name, place := range test.places {
+				if got := lobby.userMapping[name].place; got != place {
+					t.Errorf("expected %s to finish in place %d, got %d", name, place, got)
+				}
+			}
+		})
+	}
+}
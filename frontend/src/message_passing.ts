@@ -5,6 +5,38 @@
  * git: https://github.com/thesayyn/protoc-gen-ts */
 import * as dependency_1 from "./google/protobuf/timestamp";
 import * as pb_1 from "google-protobuf";
+export enum Visibility {
+    PRIVATE = 0,
+    PUBLIC = 1
+}
+export enum ScoringMode {
+    LENGTH = 0,
+    FLAT = 1
+}
+export enum JudgeMode {
+    CLIENT = 0,
+    EXACT = 1,
+    SIMILAR = 2
+}
+export enum LateJoinPolicy {
+    ALLOW_LATE_JOIN = 0,
+    DENY_LATE_JOIN = 1,
+    SPECTATE_LATE_JOIN = 2
+}
+export enum GameMode {
+    STANDARD = 0,
+    RACE = 1
+}
+export enum ReadyRequirement {
+    NO_READY_CHECK = 0,
+    ALL_READY = 1,
+    QUORUM_READY = 2
+}
+export enum Presence {
+    CONNECTED = 0,
+    DISCONNECTED = 1,
+    LEFT = 2
+}
 export class Problem extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
@@ -116,232 +148,79 @@ export class Problem extends pb_1.Message {
         return Problem.deserialize(bytes);
     }
 }
-export class ServerSent extends pb_1.Message {
-    #one_of_decls: number[][] = [[1, 2, 3, 4, 5, 6, 7]];
-    constructor(data?: any[] | ({} & (({
-        remove?: ServerSent.RemoveMember;
-        add?: never;
-        start?: never;
-        new_problem?: never;
-        end?: never;
-        score_update?: never;
-        wrong?: never;
-    } | {
-        remove?: never;
-        add?: ServerSent.AddMember;
-        start?: never;
-        new_problem?: never;
-        end?: never;
-        score_update?: never;
-        wrong?: never;
-    } | {
-        remove?: never;
-        add?: never;
-        start?: ServerSent.StartGame;
-        new_problem?: never;
-        end?: never;
-        score_update?: never;
-        wrong?: never;
-    } | {
-        remove?: never;
-        add?: never;
-        start?: never;
-        new_problem?: ServerSent.NewProblem;
-        end?: never;
-        score_update?: never;
-        wrong?: never;
-    } | {
-        remove?: never;
-        add?: never;
-        start?: never;
-        new_problem?: never;
-        end?: ServerSent.EndGame;
-        score_update?: never;
-        wrong?: never;
-    } | {
-        remove?: never;
-        add?: never;
-        start?: never;
-        new_problem?: never;
-        end?: never;
-        score_update?: ServerSent.ScoreUpdate;
-        wrong?: never;
-    } | {
-        remove?: never;
-        add?: never;
-        start?: never;
-        new_problem?: never;
-        end?: never;
-        score_update?: never;
-        wrong?: ServerSent.WrongAnswer;
-    })))) {
+export class RaceSettings extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        round_time_limit?: dependency_1.google.protobuf.Timestamp;
+        intermission?: dependency_1.google.protobuf.Timestamp;
+        position_points: number[];
+    }) {
         super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
-            if ("remove" in data && data.remove != undefined) {
-                this.remove = data.remove;
-            }
-            if ("add" in data && data.add != undefined) {
-                this.add = data.add;
-            }
-            if ("start" in data && data.start != undefined) {
-                this.start = data.start;
-            }
-            if ("new_problem" in data && data.new_problem != undefined) {
-                this.new_problem = data.new_problem;
+            if ("round_time_limit" in data && data.round_time_limit != undefined) {
+                this.round_time_limit = data.round_time_limit;
             }
-            if ("end" in data && data.end != undefined) {
-                this.end = data.end;
-            }
-            if ("score_update" in data && data.score_update != undefined) {
-                this.score_update = data.score_update;
-            }
-            if ("wrong" in data && data.wrong != undefined) {
-                this.wrong = data.wrong;
+            if ("intermission" in data && data.intermission != undefined) {
+                this.intermission = data.intermission;
             }
+            this.position_points = data.position_points;
         }
     }
-    get remove() {
-        return pb_1.Message.getWrapperField(this, ServerSent.RemoveMember, 1) as ServerSent.RemoveMember;
+    get round_time_limit() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
     }
-    set remove(value: ServerSent.RemoveMember) {
-        pb_1.Message.setOneofWrapperField(this, 1, this.#one_of_decls[0], value);
+    set round_time_limit(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 1, value);
     }
-    get has_remove() {
+    get has_round_time_limit() {
         return pb_1.Message.getField(this, 1) != null;
     }
-    get add() {
-        return pb_1.Message.getWrapperField(this, ServerSent.AddMember, 2) as ServerSent.AddMember;
+    get intermission() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 2) as dependency_1.google.protobuf.Timestamp;
     }
-    set add(value: ServerSent.AddMember) {
-        pb_1.Message.setOneofWrapperField(this, 2, this.#one_of_decls[0], value);
+    set intermission(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 2, value);
     }
-    get has_add() {
+    get has_intermission() {
         return pb_1.Message.getField(this, 2) != null;
     }
-    get start() {
-        return pb_1.Message.getWrapperField(this, ServerSent.StartGame, 3) as ServerSent.StartGame;
-    }
-    set start(value: ServerSent.StartGame) {
-        pb_1.Message.setOneofWrapperField(this, 3, this.#one_of_decls[0], value);
-    }
-    get has_start() {
-        return pb_1.Message.getField(this, 3) != null;
-    }
-    get new_problem() {
-        return pb_1.Message.getWrapperField(this, ServerSent.NewProblem, 4) as ServerSent.NewProblem;
-    }
-    set new_problem(value: ServerSent.NewProblem) {
-        pb_1.Message.setOneofWrapperField(this, 4, this.#one_of_decls[0], value);
-    }
-    get has_new_problem() {
-        return pb_1.Message.getField(this, 4) != null;
-    }
-    get end() {
-        return pb_1.Message.getWrapperField(this, ServerSent.EndGame, 5) as ServerSent.EndGame;
-    }
-    set end(value: ServerSent.EndGame) {
-        pb_1.Message.setOneofWrapperField(this, 5, this.#one_of_decls[0], value);
-    }
-    get has_end() {
-        return pb_1.Message.getField(this, 5) != null;
-    }
-    get score_update() {
-        return pb_1.Message.getWrapperField(this, ServerSent.ScoreUpdate, 6) as ServerSent.ScoreUpdate;
-    }
-    set score_update(value: ServerSent.ScoreUpdate) {
-        pb_1.Message.setOneofWrapperField(this, 6, this.#one_of_decls[0], value);
-    }
-    get has_score_update() {
-        return pb_1.Message.getField(this, 6) != null;
-    }
-    get wrong() {
-        return pb_1.Message.getWrapperField(this, ServerSent.WrongAnswer, 7) as ServerSent.WrongAnswer;
+    get position_points() {
+        return pb_1.Message.getFieldWithDefault(this, 3, []) as number[];
     }
-    set wrong(value: ServerSent.WrongAnswer) {
-        pb_1.Message.setOneofWrapperField(this, 7, this.#one_of_decls[0], value);
-    }
-    get has_wrong() {
-        return pb_1.Message.getField(this, 7) != null;
-    }
-    get message() {
-        const cases: {
-            [index: number]: "none" | "remove" | "add" | "start" | "new_problem" | "end" | "score_update" | "wrong";
-        } = {
-            0: "none",
-            1: "remove",
-            2: "add",
-            3: "start",
-            4: "new_problem",
-            5: "end",
-            6: "score_update",
-            7: "wrong"
-        };
-        return cases[pb_1.Message.computeOneofCase(this, [1, 2, 3, 4, 5, 6, 7])];
+    set position_points(value: number[]) {
+        pb_1.Message.setField(this, 3, value);
     }
     static fromObject(data: {
-        remove?: ReturnType<typeof ServerSent.RemoveMember.prototype.toObject>;
-        add?: ReturnType<typeof ServerSent.AddMember.prototype.toObject>;
-        start?: ReturnType<typeof ServerSent.StartGame.prototype.toObject>;
-        new_problem?: ReturnType<typeof ServerSent.NewProblem.prototype.toObject>;
-        end?: ReturnType<typeof ServerSent.EndGame.prototype.toObject>;
-        score_update?: ReturnType<typeof ServerSent.ScoreUpdate.prototype.toObject>;
-        wrong?: ReturnType<typeof ServerSent.WrongAnswer.prototype.toObject>;
-    }): ServerSent {
-        const message = new ServerSent({});
-        if (data.remove != null) {
-            message.remove = ServerSent.RemoveMember.fromObject(data.remove);
-        }
-        if (data.add != null) {
-            message.add = ServerSent.AddMember.fromObject(data.add);
-        }
-        if (data.start != null) {
-            message.start = ServerSent.StartGame.fromObject(data.start);
-        }
-        if (data.new_problem != null) {
-            message.new_problem = ServerSent.NewProblem.fromObject(data.new_problem);
-        }
-        if (data.end != null) {
-            message.end = ServerSent.EndGame.fromObject(data.end);
-        }
-        if (data.score_update != null) {
-            message.score_update = ServerSent.ScoreUpdate.fromObject(data.score_update);
+        round_time_limit?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        intermission?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        position_points?: number[];
+    }): RaceSettings {
+        const message = new RaceSettings({
+            position_points: data.position_points
+        });
+        if (data.round_time_limit != null) {
+            message.round_time_limit = dependency_1.google.protobuf.Timestamp.fromObject(data.round_time_limit);
         }
-        if (data.wrong != null) {
-            message.wrong = ServerSent.WrongAnswer.fromObject(data.wrong);
+        if (data.intermission != null) {
+            message.intermission = dependency_1.google.protobuf.Timestamp.fromObject(data.intermission);
         }
         return message;
     }
     toObject() {
         const data: {
-            remove?: ReturnType<typeof ServerSent.RemoveMember.prototype.toObject>;
-            add?: ReturnType<typeof ServerSent.AddMember.prototype.toObject>;
-            start?: ReturnType<typeof ServerSent.StartGame.prototype.toObject>;
-            new_problem?: ReturnType<typeof ServerSent.NewProblem.prototype.toObject>;
-            end?: ReturnType<typeof ServerSent.EndGame.prototype.toObject>;
-            score_update?: ReturnType<typeof ServerSent.ScoreUpdate.prototype.toObject>;
-            wrong?: ReturnType<typeof ServerSent.WrongAnswer.prototype.toObject>;
+            round_time_limit?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            intermission?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            position_points?: number[];
         } = {};
-        if (this.remove != null) {
-            data.remove = this.remove.toObject();
+        if (this.round_time_limit != null) {
+            data.round_time_limit = this.round_time_limit.toObject();
         }
-        if (this.add != null) {
-            data.add = this.add.toObject();
-        }
-        if (this.start != null) {
-            data.start = this.start.toObject();
-        }
-        if (this.new_problem != null) {
-            data.new_problem = this.new_problem.toObject();
+        if (this.intermission != null) {
+            data.intermission = this.intermission.toObject();
         }
-        if (this.end != null) {
-            data.end = this.end.toObject();
-        }
-        if (this.score_update != null) {
-            data.score_update = this.score_update.toObject();
-        }
-        if (this.wrong != null) {
-            data.wrong = this.wrong.toObject();
+        if (this.position_points != null) {
+            data.position_points = this.position_points;
         }
         return data;
     }
@@ -349,49 +228,29 @@ export class ServerSent extends pb_1.Message {
     serialize(w: pb_1.BinaryWriter): void;
     serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
         const writer = w || new pb_1.BinaryWriter();
-        if (this.has_remove)
-            writer.writeMessage(1, this.remove, () => this.remove.serialize(writer));
-        if (this.has_add)
-            writer.writeMessage(2, this.add, () => this.add.serialize(writer));
-        if (this.has_start)
-            writer.writeMessage(3, this.start, () => this.start.serialize(writer));
-        if (this.has_new_problem)
-            writer.writeMessage(4, this.new_problem, () => this.new_problem.serialize(writer));
-        if (this.has_end)
-            writer.writeMessage(5, this.end, () => this.end.serialize(writer));
-        if (this.has_score_update)
-            writer.writeMessage(6, this.score_update, () => this.score_update.serialize(writer));
-        if (this.has_wrong)
-            writer.writeMessage(7, this.wrong, () => this.wrong.serialize(writer));
+        if (this.has_round_time_limit)
+            writer.writeMessage(1, this.round_time_limit, () => this.round_time_limit.serialize(writer));
+        if (this.has_intermission)
+            writer.writeMessage(2, this.intermission, () => this.intermission.serialize(writer));
+        if (this.position_points.length)
+            writer.writeRepeatedInt32(3, this.position_points);
         if (!w)
             return writer.getResultBuffer();
     }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ServerSent {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ServerSent();
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RaceSettings {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RaceSettings();
         while (reader.nextField()) {
             if (reader.isEndGroup())
                 break;
             switch (reader.getFieldNumber()) {
                 case 1:
-                    reader.readMessage(message.remove, () => message.remove = ServerSent.RemoveMember.deserialize(reader));
+                    reader.readMessage(message.round_time_limit, () => message.round_time_limit = dependency_1.google.protobuf.Timestamp.deserialize(reader));
                     break;
                 case 2:
-                    reader.readMessage(message.add, () => message.add = ServerSent.AddMember.deserialize(reader));
+                    reader.readMessage(message.intermission, () => message.intermission = dependency_1.google.protobuf.Timestamp.deserialize(reader));
                     break;
                 case 3:
-                    reader.readMessage(message.start, () => message.start = ServerSent.StartGame.deserialize(reader));
-                    break;
-                case 4:
-                    reader.readMessage(message.new_problem, () => message.new_problem = ServerSent.NewProblem.deserialize(reader));
-                    break;
-                case 5:
-                    reader.readMessage(message.end, () => message.end = ServerSent.EndGame.deserialize(reader));
-                    break;
-                case 6:
-                    reader.readMessage(message.score_update, () => message.score_update = ServerSent.ScoreUpdate.deserialize(reader));
-                    break;
-                case 7:
-                    reader.readMessage(message.wrong, () => message.wrong = ServerSent.WrongAnswer.deserialize(reader));
+                    pb_1.Message.addToRepeatedField(message, 3, reader.readInt32());
                     break;
                 default: reader.skipField();
             }
@@ -401,25 +260,6917 @@ export class ServerSent extends pb_1.Message {
     serializeBinary(): Uint8Array {
         return this.serialize();
     }
-    static deserializeBinary(bytes: Uint8Array): ServerSent {
-        return ServerSent.deserialize(bytes);
+    static deserializeBinary(bytes: Uint8Array): RaceSettings {
+        return RaceSettings.deserialize(bytes);
     }
 }
-export namespace ServerSent {
-    export class RemoveMember extends pb_1.Message {
-        #one_of_decls: number[][] = [];
-        constructor(data?: any[] | {
-            name: string;
-        }) {
-            super();
-            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-            if (!Array.isArray(data) && typeof data == "object") {
-                this.name = data.name;
-            }
-        }
-        get name() {
-            return pb_1.Message.getField(this, 1) as string;
-        }
+export class EliminationSettings extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        enabled?: boolean;
+        interval?: dependency_1.google.protobuf.Timestamp;
+        per_elimination?: number;
+        sudden_death?: boolean;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("enabled" in data && data.enabled != undefined) {
+                this.enabled = data.enabled;
+            }
+            if ("interval" in data && data.interval != undefined) {
+                this.interval = data.interval;
+            }
+            if ("per_elimination" in data && data.per_elimination != undefined) {
+                this.per_elimination = data.per_elimination;
+            }
+            if ("sudden_death" in data && data.sudden_death != undefined) {
+                this.sudden_death = data.sudden_death;
+            }
+        }
+    }
+    get enabled() {
+        return pb_1.Message.getFieldWithDefault(this, 1, false) as boolean;
+    }
+    set enabled(value: boolean) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get has_enabled() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get interval() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 2) as dependency_1.google.protobuf.Timestamp;
+    }
+    set interval(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_interval() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get per_elimination() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set per_elimination(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get has_per_elimination() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    get sudden_death() {
+        return pb_1.Message.getFieldWithDefault(this, 4, false) as boolean;
+    }
+    set sudden_death(value: boolean) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get has_sudden_death() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
+    static fromObject(data: {
+        enabled?: boolean;
+        interval?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        per_elimination?: number;
+        sudden_death?: boolean;
+    }): EliminationSettings {
+        const message = new EliminationSettings({});
+        if (data.enabled != null) {
+            message.enabled = data.enabled;
+        }
+        if (data.interval != null) {
+            message.interval = dependency_1.google.protobuf.Timestamp.fromObject(data.interval);
+        }
+        if (data.per_elimination != null) {
+            message.per_elimination = data.per_elimination;
+        }
+        if (data.sudden_death != null) {
+            message.sudden_death = data.sudden_death;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            enabled?: boolean;
+            interval?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            per_elimination?: number;
+            sudden_death?: boolean;
+        } = {};
+        if (this.enabled != null) {
+            data.enabled = this.enabled;
+        }
+        if (this.interval != null) {
+            data.interval = this.interval.toObject();
+        }
+        if (this.per_elimination != null) {
+            data.per_elimination = this.per_elimination;
+        }
+        if (this.sudden_death != null) {
+            data.sudden_death = this.sudden_death;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_enabled)
+            writer.writeBool(1, this.enabled);
+        if (this.has_interval)
+            writer.writeMessage(2, this.interval, () => this.interval.serialize(writer));
+        if (this.has_per_elimination)
+            writer.writeInt32(3, this.per_elimination);
+        if (this.has_sudden_death)
+            writer.writeBool(4, this.sudden_death);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): EliminationSettings {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new EliminationSettings();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.enabled = reader.readBool();
+                    break;
+                case 2:
+                    reader.readMessage(message.interval, () => message.interval = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                    break;
+                case 3:
+                    message.per_elimination = reader.readInt32();
+                    break;
+                case 4:
+                    message.sudden_death = reader.readBool();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): EliminationSettings {
+        return EliminationSettings.deserialize(bytes);
+    }
+}
+export class WinCondition extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        target_solves?: number;
+        target_score?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("target_solves" in data && data.target_solves != undefined) {
+                this.target_solves = data.target_solves;
+            }
+            if ("target_score" in data && data.target_score != undefined) {
+                this.target_score = data.target_score;
+            }
+        }
+    }
+    get target_solves() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set target_solves(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get has_target_solves() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get target_score() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set target_score(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get has_target_score() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        target_solves?: number;
+        target_score?: number;
+    }): WinCondition {
+        const message = new WinCondition({});
+        if (data.target_solves != null) {
+            message.target_solves = data.target_solves;
+        }
+        if (data.target_score != null) {
+            message.target_score = data.target_score;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            target_solves?: number;
+            target_score?: number;
+        } = {};
+        if (this.target_solves != null) {
+            data.target_solves = this.target_solves;
+        }
+        if (this.target_score != null) {
+            data.target_score = this.target_score;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_target_solves)
+            writer.writeInt32(1, this.target_solves);
+        if (this.has_target_score)
+            writer.writeInt32(2, this.target_score);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): WinCondition {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new WinCondition();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.target_solves = reader.readInt32();
+                    break;
+                case 2:
+                    message.target_score = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): WinCondition {
+        return WinCondition.deserialize(bytes);
+    }
+}
+export class MatchSettings extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        rounds?: number;
+        intermission?: dependency_1.google.protobuf.Timestamp;
+        round_settings: LobbySettings[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("rounds" in data && data.rounds != undefined) {
+                this.rounds = data.rounds;
+            }
+            if ("intermission" in data && data.intermission != undefined) {
+                this.intermission = data.intermission;
+            }
+            this.round_settings = data.round_settings;
+        }
+    }
+    get rounds() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set rounds(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get has_rounds() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get intermission() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 2) as dependency_1.google.protobuf.Timestamp;
+    }
+    set intermission(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_intermission() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get round_settings() {
+        return pb_1.Message.getRepeatedWrapperField(this, LobbySettings, 3) as LobbySettings[];
+    }
+    set round_settings(value: LobbySettings[]) {
+        pb_1.Message.setRepeatedWrapperField(this, 3, value);
+    }
+    static fromObject(data: {
+        rounds?: number;
+        intermission?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        round_settings?: ReturnType<typeof LobbySettings.prototype.toObject>[];
+    }): MatchSettings {
+        const message = new MatchSettings({
+            round_settings: data.round_settings.map(item => LobbySettings.fromObject(item))
+        });
+        if (data.rounds != null) {
+            message.rounds = data.rounds;
+        }
+        if (data.intermission != null) {
+            message.intermission = dependency_1.google.protobuf.Timestamp.fromObject(data.intermission);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            rounds?: number;
+            intermission?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            round_settings?: ReturnType<typeof LobbySettings.prototype.toObject>[];
+        } = {};
+        if (this.rounds != null) {
+            data.rounds = this.rounds;
+        }
+        if (this.intermission != null) {
+            data.intermission = this.intermission.toObject();
+        }
+        if (this.round_settings != null) {
+            data.round_settings = this.round_settings.map((item: LobbySettings) => item.toObject());
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_rounds)
+            writer.writeInt32(1, this.rounds);
+        if (this.has_intermission)
+            writer.writeMessage(2, this.intermission, () => this.intermission.serialize(writer));
+        if (this.round_settings.length)
+            writer.writeRepeatedMessage(3, this.round_settings, (item: LobbySettings) => item.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): MatchSettings {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new MatchSettings();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.rounds = reader.readInt32();
+                    break;
+                case 2:
+                    reader.readMessage(message.intermission, () => message.intermission = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                    break;
+                case 3:
+                    reader.readMessage(message.round_settings, () => pb_1.Message.addToRepeatedWrapperField(message, 3, LobbySettings.deserialize(reader), LobbySettings));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): MatchSettings {
+        return MatchSettings.deserialize(bytes);
+    }
+}
+export class ProblemFilter extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        min_length?: number;
+        max_length?: number;
+        search?: string;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("min_length" in data && data.min_length != undefined) {
+                this.min_length = data.min_length;
+            }
+            if ("max_length" in data && data.max_length != undefined) {
+                this.max_length = data.max_length;
+            }
+            if ("search" in data && data.search != undefined) {
+                this.search = data.search;
+            }
+        }
+    }
+    get min_length() {
+        return pb_1.Message.getFieldWithDefault(this, 1, 0) as number;
+    }
+    set min_length(value: number) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get has_min_length() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get max_length() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set max_length(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get has_max_length() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get search() {
+        return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+    }
+    set search(value: string) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get has_search() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    static fromObject(data: {
+        min_length?: number;
+        max_length?: number;
+        search?: string;
+    }): ProblemFilter {
+        const message = new ProblemFilter({});
+        if (data.min_length != null) {
+            message.min_length = data.min_length;
+        }
+        if (data.max_length != null) {
+            message.max_length = data.max_length;
+        }
+        if (data.search != null) {
+            message.search = data.search;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            min_length?: number;
+            max_length?: number;
+            search?: string;
+        } = {};
+        if (this.min_length != null) {
+            data.min_length = this.min_length;
+        }
+        if (this.max_length != null) {
+            data.max_length = this.max_length;
+        }
+        if (this.search != null) {
+            data.search = this.search;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_min_length)
+            writer.writeInt32(1, this.min_length);
+        if (this.has_max_length)
+            writer.writeInt32(2, this.max_length);
+        if (this.has_search && this.search.length)
+            writer.writeString(3, this.search);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ProblemFilter {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ProblemFilter();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.min_length = reader.readInt32();
+                    break;
+                case 2:
+                    message.max_length = reader.readInt32();
+                    break;
+                case 3:
+                    message.search = reader.readString();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ProblemFilter {
+        return ProblemFilter.deserialize(bytes);
+    }
+}
+export class LobbySettings extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        duration?: dependency_1.google.protobuf.Timestamp;
+        max_players?: number;
+        visibility?: Visibility;
+        scoring_mode?: ScoringMode;
+        judge_mode?: JudgeMode;
+        problem_filter?: ProblemFilter;
+        late_join?: LateJoinPolicy;
+        countdown?: dependency_1.google.protobuf.Timestamp;
+        random_order?: boolean;
+        team_shared_problems?: boolean;
+        game_mode?: GameMode;
+        race?: RaceSettings;
+        elimination?: EliminationSettings;
+        win_condition?: WinCondition;
+        chat_disabled_in_play?: boolean;
+        ready_requirement?: ReadyRequirement;
+        ready_quorum?: number;
+        late_join_allowance?: number;
+        leave_after?: dependency_1.google.protobuf.Timestamp;
+        match?: MatchSettings;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("duration" in data && data.duration != undefined) {
+                this.duration = data.duration;
+            }
+            if ("max_players" in data && data.max_players != undefined) {
+                this.max_players = data.max_players;
+            }
+            if ("visibility" in data && data.visibility != undefined) {
+                this.visibility = data.visibility;
+            }
+            if ("scoring_mode" in data && data.scoring_mode != undefined) {
+                this.scoring_mode = data.scoring_mode;
+            }
+            if ("judge_mode" in data && data.judge_mode != undefined) {
+                this.judge_mode = data.judge_mode;
+            }
+            if ("problem_filter" in data && data.problem_filter != undefined) {
+                this.problem_filter = data.problem_filter;
+            }
+            if ("late_join" in data && data.late_join != undefined) {
+                this.late_join = data.late_join;
+            }
+            if ("countdown" in data && data.countdown != undefined) {
+                this.countdown = data.countdown;
+            }
+            if ("random_order" in data && data.random_order != undefined) {
+                this.random_order = data.random_order;
+            }
+            if ("team_shared_problems" in data && data.team_shared_problems != undefined) {
+                this.team_shared_problems = data.team_shared_problems;
+            }
+            if ("game_mode" in data && data.game_mode != undefined) {
+                this.game_mode = data.game_mode;
+            }
+            if ("race" in data && data.race != undefined) {
+                this.race = data.race;
+            }
+            if ("elimination" in data && data.elimination != undefined) {
+                this.elimination = data.elimination;
+            }
+            if ("win_condition" in data && data.win_condition != undefined) {
+                this.win_condition = data.win_condition;
+            }
+            if ("chat_disabled_in_play" in data && data.chat_disabled_in_play != undefined) {
+                this.chat_disabled_in_play = data.chat_disabled_in_play;
+            }
+            if ("ready_requirement" in data && data.ready_requirement != undefined) {
+                this.ready_requirement = data.ready_requirement;
+            }
+            if ("ready_quorum" in data && data.ready_quorum != undefined) {
+                this.ready_quorum = data.ready_quorum;
+            }
+            if ("late_join_allowance" in data && data.late_join_allowance != undefined) {
+                this.late_join_allowance = data.late_join_allowance;
+            }
+            if ("leave_after" in data && data.leave_after != undefined) {
+                this.leave_after = data.leave_after;
+            }
+            if ("match" in data && data.match != undefined) {
+                this.match = data.match;
+            }
+        }
+    }
+    get duration() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+    }
+    set duration(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 1, value);
+    }
+    get has_duration() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get max_players() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set max_players(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get has_max_players() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get visibility() {
+        return pb_1.Message.getFieldWithDefault(this, 3, Visibility.PRIVATE) as Visibility;
+    }
+    set visibility(value: Visibility) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get has_visibility() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    get scoring_mode() {
+        return pb_1.Message.getFieldWithDefault(this, 4, ScoringMode.LENGTH) as ScoringMode;
+    }
+    set scoring_mode(value: ScoringMode) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get has_scoring_mode() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
+    get judge_mode() {
+        return pb_1.Message.getFieldWithDefault(this, 5, JudgeMode.CLIENT) as JudgeMode;
+    }
+    set judge_mode(value: JudgeMode) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get has_judge_mode() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    get problem_filter() {
+        return pb_1.Message.getWrapperField(this, ProblemFilter, 6) as ProblemFilter;
+    }
+    set problem_filter(value: ProblemFilter) {
+        pb_1.Message.setWrapperField(this, 6, value);
+    }
+    get has_problem_filter() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get late_join() {
+        return pb_1.Message.getFieldWithDefault(this, 7, LateJoinPolicy.ALLOW_LATE_JOIN) as LateJoinPolicy;
+    }
+    set late_join(value: LateJoinPolicy) {
+        pb_1.Message.setField(this, 7, value);
+    }
+    get has_late_join() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    get countdown() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 8) as dependency_1.google.protobuf.Timestamp;
+    }
+    set countdown(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 8, value);
+    }
+    get has_countdown() {
+        return pb_1.Message.getField(this, 8) != null;
+    }
+    get random_order() {
+        return pb_1.Message.getFieldWithDefault(this, 9, false) as boolean;
+    }
+    set random_order(value: boolean) {
+        pb_1.Message.setField(this, 9, value);
+    }
+    get has_random_order() {
+        return pb_1.Message.getField(this, 9) != null;
+    }
+    get team_shared_problems() {
+        return pb_1.Message.getFieldWithDefault(this, 10, false) as boolean;
+    }
+    set team_shared_problems(value: boolean) {
+        pb_1.Message.setField(this, 10, value);
+    }
+    get has_team_shared_problems() {
+        return pb_1.Message.getField(this, 10) != null;
+    }
+    get game_mode() {
+        return pb_1.Message.getFieldWithDefault(this, 11, GameMode.STANDARD) as GameMode;
+    }
+    set game_mode(value: GameMode) {
+        pb_1.Message.setField(this, 11, value);
+    }
+    get has_game_mode() {
+        return pb_1.Message.getField(this, 11) != null;
+    }
+    get race() {
+        return pb_1.Message.getWrapperField(this, RaceSettings, 12) as RaceSettings;
+    }
+    set race(value: RaceSettings) {
+        pb_1.Message.setWrapperField(this, 12, value);
+    }
+    get has_race() {
+        return pb_1.Message.getField(this, 12) != null;
+    }
+    get elimination() {
+        return pb_1.Message.getWrapperField(this, EliminationSettings, 13) as EliminationSettings;
+    }
+    set elimination(value: EliminationSettings) {
+        pb_1.Message.setWrapperField(this, 13, value);
+    }
+    get has_elimination() {
+        return pb_1.Message.getField(this, 13) != null;
+    }
+    get win_condition() {
+        return pb_1.Message.getWrapperField(this, WinCondition, 14) as WinCondition;
+    }
+    set win_condition(value: WinCondition) {
+        pb_1.Message.setWrapperField(this, 14, value);
+    }
+    get has_win_condition() {
+        return pb_1.Message.getField(this, 14) != null;
+    }
+    get chat_disabled_in_play() {
+        return pb_1.Message.getFieldWithDefault(this, 15, false) as boolean;
+    }
+    set chat_disabled_in_play(value: boolean) {
+        pb_1.Message.setField(this, 15, value);
+    }
+    get has_chat_disabled_in_play() {
+        return pb_1.Message.getField(this, 15) != null;
+    }
+    get ready_requirement() {
+        return pb_1.Message.getFieldWithDefault(this, 16, ReadyRequirement.NO_READY_CHECK) as ReadyRequirement;
+    }
+    set ready_requirement(value: ReadyRequirement) {
+        pb_1.Message.setField(this, 16, value);
+    }
+    get has_ready_requirement() {
+        return pb_1.Message.getField(this, 16) != null;
+    }
+    get ready_quorum() {
+        return pb_1.Message.getFieldWithDefault(this, 17, 0) as number;
+    }
+    set ready_quorum(value: number) {
+        pb_1.Message.setField(this, 17, value);
+    }
+    get has_ready_quorum() {
+        return pb_1.Message.getField(this, 17) != null;
+    }
+    get late_join_allowance() {
+        return pb_1.Message.getFieldWithDefault(this, 18, 0) as number;
+    }
+    set late_join_allowance(value: number) {
+        pb_1.Message.setField(this, 18, value);
+    }
+    get has_late_join_allowance() {
+        return pb_1.Message.getField(this, 18) != null;
+    }
+    get leave_after() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 19) as dependency_1.google.protobuf.Timestamp;
+    }
+    set leave_after(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 19, value);
+    }
+    get has_leave_after() {
+        return pb_1.Message.getField(this, 19) != null;
+    }
+    get match() {
+        return pb_1.Message.getWrapperField(this, MatchSettings, 20) as MatchSettings;
+    }
+    set match(value: MatchSettings) {
+        pb_1.Message.setWrapperField(this, 20, value);
+    }
+    get has_match() {
+        return pb_1.Message.getField(this, 20) != null;
+    }
+    static fromObject(data: {
+        duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        max_players?: number;
+        visibility?: Visibility;
+        scoring_mode?: ScoringMode;
+        judge_mode?: JudgeMode;
+        problem_filter?: ReturnType<typeof ProblemFilter.prototype.toObject>;
+        late_join?: LateJoinPolicy;
+        countdown?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        random_order?: boolean;
+        team_shared_problems?: boolean;
+        game_mode?: GameMode;
+        race?: ReturnType<typeof RaceSettings.prototype.toObject>;
+        elimination?: ReturnType<typeof EliminationSettings.prototype.toObject>;
+        win_condition?: ReturnType<typeof WinCondition.prototype.toObject>;
+        chat_disabled_in_play?: boolean;
+        ready_requirement?: ReadyRequirement;
+        ready_quorum?: number;
+        late_join_allowance?: number;
+        leave_after?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        match?: ReturnType<typeof MatchSettings.prototype.toObject>;
+    }): LobbySettings {
+        const message = new LobbySettings({});
+        if (data.duration != null) {
+            message.duration = dependency_1.google.protobuf.Timestamp.fromObject(data.duration);
+        }
+        if (data.max_players != null) {
+            message.max_players = data.max_players;
+        }
+        if (data.visibility != null) {
+            message.visibility = data.visibility;
+        }
+        if (data.scoring_mode != null) {
+            message.scoring_mode = data.scoring_mode;
+        }
+        if (data.judge_mode != null) {
+            message.judge_mode = data.judge_mode;
+        }
+        if (data.problem_filter != null) {
+            message.problem_filter = ProblemFilter.fromObject(data.problem_filter);
+        }
+        if (data.late_join != null) {
+            message.late_join = data.late_join;
+        }
+        if (data.countdown != null) {
+            message.countdown = dependency_1.google.protobuf.Timestamp.fromObject(data.countdown);
+        }
+        if (data.random_order != null) {
+            message.random_order = data.random_order;
+        }
+        if (data.team_shared_problems != null) {
+            message.team_shared_problems = data.team_shared_problems;
+        }
+        if (data.game_mode != null) {
+            message.game_mode = data.game_mode;
+        }
+        if (data.race != null) {
+            message.race = RaceSettings.fromObject(data.race);
+        }
+        if (data.elimination != null) {
+            message.elimination = EliminationSettings.fromObject(data.elimination);
+        }
+        if (data.win_condition != null) {
+            message.win_condition = WinCondition.fromObject(data.win_condition);
+        }
+        if (data.chat_disabled_in_play != null) {
+            message.chat_disabled_in_play = data.chat_disabled_in_play;
+        }
+        if (data.ready_requirement != null) {
+            message.ready_requirement = data.ready_requirement;
+        }
+        if (data.ready_quorum != null) {
+            message.ready_quorum = data.ready_quorum;
+        }
+        if (data.late_join_allowance != null) {
+            message.late_join_allowance = data.late_join_allowance;
+        }
+        if (data.leave_after != null) {
+            message.leave_after = dependency_1.google.protobuf.Timestamp.fromObject(data.leave_after);
+        }
+        if (data.match != null) {
+            message.match = MatchSettings.fromObject(data.match);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            max_players?: number;
+            visibility?: Visibility;
+            scoring_mode?: ScoringMode;
+            judge_mode?: JudgeMode;
+            problem_filter?: ReturnType<typeof ProblemFilter.prototype.toObject>;
+            late_join?: LateJoinPolicy;
+            countdown?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            random_order?: boolean;
+            team_shared_problems?: boolean;
+            game_mode?: GameMode;
+            race?: ReturnType<typeof RaceSettings.prototype.toObject>;
+            elimination?: ReturnType<typeof EliminationSettings.prototype.toObject>;
+            win_condition?: ReturnType<typeof WinCondition.prototype.toObject>;
+            chat_disabled_in_play?: boolean;
+            ready_requirement?: ReadyRequirement;
+            ready_quorum?: number;
+            late_join_allowance?: number;
+            leave_after?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            match?: ReturnType<typeof MatchSettings.prototype.toObject>;
+        } = {};
+        if (this.duration != null) {
+            data.duration = this.duration.toObject();
+        }
+        if (this.max_players != null) {
+            data.max_players = this.max_players;
+        }
+        if (this.visibility != null) {
+            data.visibility = this.visibility;
+        }
+        if (this.scoring_mode != null) {
+            data.scoring_mode = this.scoring_mode;
+        }
+        if (this.judge_mode != null) {
+            data.judge_mode = this.judge_mode;
+        }
+        if (this.problem_filter != null) {
+            data.problem_filter = this.problem_filter.toObject();
+        }
+        if (this.late_join != null) {
+            data.late_join = this.late_join;
+        }
+        if (this.countdown != null) {
+            data.countdown = this.countdown.toObject();
+        }
+        if (this.random_order != null) {
+            data.random_order = this.random_order;
+        }
+        if (this.team_shared_problems != null) {
+            data.team_shared_problems = this.team_shared_problems;
+        }
+        if (this.game_mode != null) {
+            data.game_mode = this.game_mode;
+        }
+        if (this.race != null) {
+            data.race = this.race.toObject();
+        }
+        if (this.elimination != null) {
+            data.elimination = this.elimination.toObject();
+        }
+        if (this.win_condition != null) {
+            data.win_condition = this.win_condition.toObject();
+        }
+        if (this.chat_disabled_in_play != null) {
+            data.chat_disabled_in_play = this.chat_disabled_in_play;
+        }
+        if (this.ready_requirement != null) {
+            data.ready_requirement = this.ready_requirement;
+        }
+        if (this.ready_quorum != null) {
+            data.ready_quorum = this.ready_quorum;
+        }
+        if (this.late_join_allowance != null) {
+            data.late_join_allowance = this.late_join_allowance;
+        }
+        if (this.leave_after != null) {
+            data.leave_after = this.leave_after.toObject();
+        }
+        if (this.match != null) {
+            data.match = this.match.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_duration)
+            writer.writeMessage(1, this.duration, () => this.duration.serialize(writer));
+        if (this.has_max_players)
+            writer.writeInt32(2, this.max_players);
+        if (this.has_visibility)
+            writer.writeEnum(3, this.visibility);
+        if (this.has_scoring_mode)
+            writer.writeEnum(4, this.scoring_mode);
+        if (this.has_judge_mode)
+            writer.writeEnum(5, this.judge_mode);
+        if (this.has_problem_filter)
+            writer.writeMessage(6, this.problem_filter, () => this.problem_filter.serialize(writer));
+        if (this.has_late_join)
+            writer.writeEnum(7, this.late_join);
+        if (this.has_countdown)
+            writer.writeMessage(8, this.countdown, () => this.countdown.serialize(writer));
+        if (this.has_random_order)
+            writer.writeBool(9, this.random_order);
+        if (this.has_team_shared_problems)
+            writer.writeBool(10, this.team_shared_problems);
+        if (this.has_game_mode)
+            writer.writeEnum(11, this.game_mode);
+        if (this.has_race)
+            writer.writeMessage(12, this.race, () => this.race.serialize(writer));
+        if (this.has_elimination)
+            writer.writeMessage(13, this.elimination, () => this.elimination.serialize(writer));
+        if (this.has_win_condition)
+            writer.writeMessage(14, this.win_condition, () => this.win_condition.serialize(writer));
+        if (this.has_chat_disabled_in_play)
+            writer.writeBool(15, this.chat_disabled_in_play);
+        if (this.has_ready_requirement)
+            writer.writeEnum(16, this.ready_requirement);
+        if (this.has_ready_quorum)
+            writer.writeInt32(17, this.ready_quorum);
+        if (this.has_late_join_allowance)
+            writer.writeInt32(18, this.late_join_allowance);
+        if (this.has_leave_after)
+            writer.writeMessage(19, this.leave_after, () => this.leave_after.serialize(writer));
+        if (this.has_match)
+            writer.writeMessage(20, this.match, () => this.match.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LobbySettings {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LobbySettings();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.duration, () => message.duration = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                    break;
+                case 2:
+                    message.max_players = reader.readInt32();
+                    break;
+                case 3:
+                    message.visibility = reader.readEnum();
+                    break;
+                case 4:
+                    message.scoring_mode = reader.readEnum();
+                    break;
+                case 5:
+                    message.judge_mode = reader.readEnum();
+                    break;
+                case 6:
+                    reader.readMessage(message.problem_filter, () => message.problem_filter = ProblemFilter.deserialize(reader));
+                    break;
+                case 7:
+                    message.late_join = reader.readEnum();
+                    break;
+                case 8:
+                    reader.readMessage(message.countdown, () => message.countdown = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                    break;
+                case 9:
+                    message.random_order = reader.readBool();
+                    break;
+                case 10:
+                    message.team_shared_problems = reader.readBool();
+                    break;
+                case 11:
+                    message.game_mode = reader.readEnum();
+                    break;
+                case 12:
+                    reader.readMessage(message.race, () => message.race = RaceSettings.deserialize(reader));
+                    break;
+                case 13:
+                    reader.readMessage(message.elimination, () => message.elimination = EliminationSettings.deserialize(reader));
+                    break;
+                case 14:
+                    reader.readMessage(message.win_condition, () => message.win_condition = WinCondition.deserialize(reader));
+                    break;
+                case 15:
+                    message.chat_disabled_in_play = reader.readBool();
+                    break;
+                case 16:
+                    message.ready_requirement = reader.readEnum();
+                    break;
+                case 17:
+                    message.ready_quorum = reader.readInt32();
+                    break;
+                case 18:
+                    message.late_join_allowance = reader.readInt32();
+                    break;
+                case 19:
+                    reader.readMessage(message.leave_after, () => message.leave_after = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                    break;
+                case 20:
+                    reader.readMessage(message.match, () => message.match = MatchSettings.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): LobbySettings {
+        return LobbySettings.deserialize(bytes);
+    }
+}
+export class Handicap extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        extra_time?: dependency_1.google.protobuf.Timestamp;
+        score_multiplier?: number;
+        head_start?: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("extra_time" in data && data.extra_time != undefined) {
+                this.extra_time = data.extra_time;
+            }
+            if ("score_multiplier" in data && data.score_multiplier != undefined) {
+                this.score_multiplier = data.score_multiplier;
+            }
+            if ("head_start" in data && data.head_start != undefined) {
+                this.head_start = data.head_start;
+            }
+        }
+    }
+    get extra_time() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+    }
+    set extra_time(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 1, value);
+    }
+    get has_extra_time() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get score_multiplier() {
+        return pb_1.Message.getFieldWithDefault(this, 2, 0) as number;
+    }
+    set score_multiplier(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get has_score_multiplier() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get head_start() {
+        return pb_1.Message.getFieldWithDefault(this, 3, 0) as number;
+    }
+    set head_start(value: number) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get has_head_start() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    static fromObject(data: {
+        extra_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        score_multiplier?: number;
+        head_start?: number;
+    }): Handicap {
+        const message = new Handicap({});
+        if (data.extra_time != null) {
+            message.extra_time = dependency_1.google.protobuf.Timestamp.fromObject(data.extra_time);
+        }
+        if (data.score_multiplier != null) {
+            message.score_multiplier = data.score_multiplier;
+        }
+        if (data.head_start != null) {
+            message.head_start = data.head_start;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            extra_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            score_multiplier?: number;
+            head_start?: number;
+        } = {};
+        if (this.extra_time != null) {
+            data.extra_time = this.extra_time.toObject();
+        }
+        if (this.score_multiplier != null) {
+            data.score_multiplier = this.score_multiplier;
+        }
+        if (this.head_start != null) {
+            data.head_start = this.head_start;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_extra_time)
+            writer.writeMessage(1, this.extra_time, () => this.extra_time.serialize(writer));
+        if (this.has_score_multiplier)
+            writer.writeFloat(2, this.score_multiplier);
+        if (this.has_head_start)
+            writer.writeInt32(3, this.head_start);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Handicap {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Handicap();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.extra_time, () => message.extra_time = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                    break;
+                case 2:
+                    message.score_multiplier = reader.readFloat();
+                    break;
+                case 3:
+                    message.head_start = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Handicap {
+        return Handicap.deserialize(bytes);
+    }
+}
+export class Member extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        name: string;
+        handicap?: Handicap;
+        ready?: boolean;
+        score?: number;
+        presence?: Presence;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            this.name = data.name;
+            if ("handicap" in data && data.handicap != undefined) {
+                this.handicap = data.handicap;
+            }
+            if ("ready" in data && data.ready != undefined) {
+                this.ready = data.ready;
+            }
+            if ("score" in data && data.score != undefined) {
+                this.score = data.score;
+            }
+            if ("presence" in data && data.presence != undefined) {
+                this.presence = data.presence;
+            }
+        }
+    }
+    get name() {
+        return pb_1.Message.getField(this, 1) as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get has_name() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get handicap() {
+        return pb_1.Message.getWrapperField(this, Handicap, 2) as Handicap;
+    }
+    set handicap(value: Handicap) {
+        pb_1.Message.setWrapperField(this, 2, value);
+    }
+    get has_handicap() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get ready() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set ready(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get has_ready() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    get score() {
+        return pb_1.Message.getFieldWithDefault(this, 4, 0) as number;
+    }
+    set score(value: number) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get has_score() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
+    get presence() {
+        return pb_1.Message.getFieldWithDefault(this, 5, Presence.CONNECTED) as Presence;
+    }
+    set presence(value: Presence) {
+        pb_1.Message.setField(this, 5, value);
+    }
+    get has_presence() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    static fromObject(data: {
+        name?: string;
+        handicap?: ReturnType<typeof Handicap.prototype.toObject>;
+        ready?: boolean;
+        score?: number;
+        presence?: Presence;
+    }): Member {
+        const message = new Member({
+            name: data.name
+        });
+        if (data.handicap != null) {
+            message.handicap = Handicap.fromObject(data.handicap);
+        }
+        if (data.ready != null) {
+            message.ready = data.ready;
+        }
+        if (data.score != null) {
+            message.score = data.score;
+        }
+        if (data.presence != null) {
+            message.presence = data.presence;
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            name?: string;
+            handicap?: ReturnType<typeof Handicap.prototype.toObject>;
+            ready?: boolean;
+            score?: number;
+            presence?: Presence;
+        } = {};
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.handicap != null) {
+            data.handicap = this.handicap.toObject();
+        }
+        if (this.ready != null) {
+            data.ready = this.ready;
+        }
+        if (this.score != null) {
+            data.score = this.score;
+        }
+        if (this.presence != null) {
+            data.presence = this.presence;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_name && this.name.length)
+            writer.writeString(1, this.name);
+        if (this.has_handicap)
+            writer.writeMessage(2, this.handicap, () => this.handicap.serialize(writer));
+        if (this.has_ready)
+            writer.writeBool(3, this.ready);
+        if (this.has_score)
+            writer.writeInt32(4, this.score);
+        if (this.has_presence)
+            writer.writeEnum(5, this.presence);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Member {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Member();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.name = reader.readString();
+                    break;
+                case 2:
+                    reader.readMessage(message.handicap, () => message.handicap = Handicap.deserialize(reader));
+                    break;
+                case 3:
+                    message.ready = reader.readBool();
+                    break;
+                case 4:
+                    message.score = reader.readInt32();
+                    break;
+                case 5:
+                    message.presence = reader.readEnum();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Member {
+        return Member.deserialize(bytes);
+    }
+}
+export class Standing extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        name: string;
+        score: number;
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            this.name = data.name;
+            this.score = data.score;
+        }
+    }
+    get name() {
+        return pb_1.Message.getField(this, 1) as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get has_name() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get score() {
+        return pb_1.Message.getField(this, 2) as number;
+    }
+    set score(value: number) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get has_score() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    static fromObject(data: {
+        name?: string;
+        score?: number;
+    }): Standing {
+        const message = new Standing({
+            name: data.name,
+            score: data.score
+        });
+        return message;
+    }
+    toObject() {
+        const data: {
+            name?: string;
+            score?: number;
+        } = {};
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.score != null) {
+            data.score = this.score;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_name && this.name.length)
+            writer.writeString(1, this.name);
+        if (this.has_score)
+            writer.writeInt32(2, this.score);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Standing {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Standing();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.name = reader.readString();
+                    break;
+                case 2:
+                    message.score = reader.readInt32();
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Standing {
+        return Standing.deserialize(bytes);
+    }
+}
+export class Team extends pb_1.Message {
+    #one_of_decls: number[][] = [];
+    constructor(data?: any[] | {
+        name: string;
+        members: string[];
+    }) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            this.name = data.name;
+            this.members = data.members;
+        }
+    }
+    get name() {
+        return pb_1.Message.getField(this, 1) as string;
+    }
+    set name(value: string) {
+        pb_1.Message.setField(this, 1, value);
+    }
+    get has_name() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get members() {
+        return pb_1.Message.getFieldWithDefault(this, 2, []) as string[];
+    }
+    set members(value: string[]) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    static fromObject(data: {
+        name?: string;
+        members?: string[];
+    }): Team {
+        const message = new Team({
+            name: data.name,
+            members: data.members
+        });
+        return message;
+    }
+    toObject() {
+        const data: {
+            name?: string;
+            members?: string[];
+        } = {};
+        if (this.name != null) {
+            data.name = this.name;
+        }
+        if (this.members != null) {
+            data.members = this.members;
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_name && this.name.length)
+            writer.writeString(1, this.name);
+        if (this.members.length)
+            writer.writeRepeatedString(2, this.members);
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Team {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Team();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    message.name = reader.readString();
+                    break;
+                case 2:
+                    pb_1.Message.addToRepeatedField(message, 2, reader.readString());
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): Team {
+        return Team.deserialize(bytes);
+    }
+}
+export class ServerSent extends pb_1.Message {
+    #one_of_decls: number[][] = [[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 26, 27]];
+    constructor(data?: any[] | ({
+        seq?: number;
+    } & (({
+        remove?: ServerSent.RemoveMember;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: ServerSent.AddMember;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: ServerSent.StartGame;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: ServerSent.NewProblem;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: ServerSent.EndGame;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: ServerSent.ScoreUpdate;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: ServerSent.WrongAnswer;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: ServerSent.Rematch;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: ServerSent.Countdown;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: ServerSent.Paused;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: ServerSent.Resumed;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: ServerSent.OwnerChanged;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: ServerSent.Settings;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: ServerSent.Error;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: ServerSent.FollowedProblem;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: ServerSent.Teams;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: ServerSent.RoundStart;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: ServerSent.RoundEnd;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: ServerSent.Eliminated;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: ServerSent.MemberUpdate;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: ServerSent.Progress;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: ServerSent.Chat;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: ServerSent.Scheduled;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: ServerSent.Session;
+        snapshot?: never;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: ServerSent.LobbySnapshot;
+        match_round_end?: never;
+    } | {
+        remove?: never;
+        add?: never;
+        start?: never;
+        new_problem?: never;
+        end?: never;
+        score_update?: never;
+        wrong?: never;
+        rematch?: never;
+        countdown?: never;
+        paused?: never;
+        resumed?: never;
+        owner_changed?: never;
+        settings?: never;
+        error?: never;
+        followed_problem?: never;
+        teams?: never;
+        round_start?: never;
+        round_end?: never;
+        eliminated?: never;
+        member_update?: never;
+        progress?: never;
+        chat?: never;
+        scheduled?: never;
+        session?: never;
+        snapshot?: never;
+        match_round_end?: ServerSent.MatchRoundEnd;
+    })))) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("seq" in data && data.seq != undefined) {
+                this.seq = data.seq;
+            }
+            if ("remove" in data && data.remove != undefined) {
+                this.remove = data.remove;
+            }
+            if ("add" in data && data.add != undefined) {
+                this.add = data.add;
+            }
+            if ("start" in data && data.start != undefined) {
+                this.start = data.start;
+            }
+            if ("new_problem" in data && data.new_problem != undefined) {
+                this.new_problem = data.new_problem;
+            }
+            if ("end" in data && data.end != undefined) {
+                this.end = data.end;
+            }
+            if ("score_update" in data && data.score_update != undefined) {
+                this.score_update = data.score_update;
+            }
+            if ("wrong" in data && data.wrong != undefined) {
+                this.wrong = data.wrong;
+            }
+            if ("rematch" in data && data.rematch != undefined) {
+                this.rematch = data.rematch;
+            }
+            if ("countdown" in data && data.countdown != undefined) {
+                this.countdown = data.countdown;
+            }
+            if ("paused" in data && data.paused != undefined) {
+                this.paused = data.paused;
+            }
+            if ("resumed" in data && data.resumed != undefined) {
+                this.resumed = data.resumed;
+            }
+            if ("owner_changed" in data && data.owner_changed != undefined) {
+                this.owner_changed = data.owner_changed;
+            }
+            if ("settings" in data && data.settings != undefined) {
+                this.settings = data.settings;
+            }
+            if ("error" in data && data.error != undefined) {
+                this.error = data.error;
+            }
+            if ("followed_problem" in data && data.followed_problem != undefined) {
+                this.followed_problem = data.followed_problem;
+            }
+            if ("teams" in data && data.teams != undefined) {
+                this.teams = data.teams;
+            }
+            if ("round_start" in data && data.round_start != undefined) {
+                this.round_start = data.round_start;
+            }
+            if ("round_end" in data && data.round_end != undefined) {
+                this.round_end = data.round_end;
+            }
+            if ("eliminated" in data && data.eliminated != undefined) {
+                this.eliminated = data.eliminated;
+            }
+            if ("member_update" in data && data.member_update != undefined) {
+                this.member_update = data.member_update;
+            }
+            if ("progress" in data && data.progress != undefined) {
+                this.progress = data.progress;
+            }
+            if ("chat" in data && data.chat != undefined) {
+                this.chat = data.chat;
+            }
+            if ("scheduled" in data && data.scheduled != undefined) {
+                this.scheduled = data.scheduled;
+            }
+            if ("session" in data && data.session != undefined) {
+                this.session = data.session;
+            }
+            if ("snapshot" in data && data.snapshot != undefined) {
+                this.snapshot = data.snapshot;
+            }
+            if ("match_round_end" in data && data.match_round_end != undefined) {
+                this.match_round_end = data.match_round_end;
+            }
+        }
+    }
+    get seq() {
+        return pb_1.Message.getFieldWithDefault(this, 25, 0) as number;
+    }
+    set seq(value: number) {
+        pb_1.Message.setField(this, 25, value);
+    }
+    get has_seq() {
+        return pb_1.Message.getField(this, 25) != null;
+    }
+    get remove() {
+        return pb_1.Message.getWrapperField(this, ServerSent.RemoveMember, 1) as ServerSent.RemoveMember;
+    }
+    set remove(value: ServerSent.RemoveMember) {
+        pb_1.Message.setOneofWrapperField(this, 1, this.#one_of_decls[0], value);
+    }
+    get has_remove() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get add() {
+        return pb_1.Message.getWrapperField(this, ServerSent.AddMember, 2) as ServerSent.AddMember;
+    }
+    set add(value: ServerSent.AddMember) {
+        pb_1.Message.setOneofWrapperField(this, 2, this.#one_of_decls[0], value);
+    }
+    get has_add() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get start() {
+        return pb_1.Message.getWrapperField(this, ServerSent.StartGame, 3) as ServerSent.StartGame;
+    }
+    set start(value: ServerSent.StartGame) {
+        pb_1.Message.setOneofWrapperField(this, 3, this.#one_of_decls[0], value);
+    }
+    get has_start() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    get new_problem() {
+        return pb_1.Message.getWrapperField(this, ServerSent.NewProblem, 4) as ServerSent.NewProblem;
+    }
+    set new_problem(value: ServerSent.NewProblem) {
+        pb_1.Message.setOneofWrapperField(this, 4, this.#one_of_decls[0], value);
+    }
+    get has_new_problem() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
+    get end() {
+        return pb_1.Message.getWrapperField(this, ServerSent.EndGame, 5) as ServerSent.EndGame;
+    }
+    set end(value: ServerSent.EndGame) {
+        pb_1.Message.setOneofWrapperField(this, 5, this.#one_of_decls[0], value);
+    }
+    get has_end() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    get score_update() {
+        return pb_1.Message.getWrapperField(this, ServerSent.ScoreUpdate, 6) as ServerSent.ScoreUpdate;
+    }
+    set score_update(value: ServerSent.ScoreUpdate) {
+        pb_1.Message.setOneofWrapperField(this, 6, this.#one_of_decls[0], value);
+    }
+    get has_score_update() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get wrong() {
+        return pb_1.Message.getWrapperField(this, ServerSent.WrongAnswer, 7) as ServerSent.WrongAnswer;
+    }
+    set wrong(value: ServerSent.WrongAnswer) {
+        pb_1.Message.setOneofWrapperField(this, 7, this.#one_of_decls[0], value);
+    }
+    get has_wrong() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    get rematch() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Rematch, 8) as ServerSent.Rematch;
+    }
+    set rematch(value: ServerSent.Rematch) {
+        pb_1.Message.setOneofWrapperField(this, 8, this.#one_of_decls[0], value);
+    }
+    get has_rematch() {
+        return pb_1.Message.getField(this, 8) != null;
+    }
+    get countdown() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Countdown, 9) as ServerSent.Countdown;
+    }
+    set countdown(value: ServerSent.Countdown) {
+        pb_1.Message.setOneofWrapperField(this, 9, this.#one_of_decls[0], value);
+    }
+    get has_countdown() {
+        return pb_1.Message.getField(this, 9) != null;
+    }
+    get paused() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Paused, 10) as ServerSent.Paused;
+    }
+    set paused(value: ServerSent.Paused) {
+        pb_1.Message.setOneofWrapperField(this, 10, this.#one_of_decls[0], value);
+    }
+    get has_paused() {
+        return pb_1.Message.getField(this, 10) != null;
+    }
+    get resumed() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Resumed, 11) as ServerSent.Resumed;
+    }
+    set resumed(value: ServerSent.Resumed) {
+        pb_1.Message.setOneofWrapperField(this, 11, this.#one_of_decls[0], value);
+    }
+    get has_resumed() {
+        return pb_1.Message.getField(this, 11) != null;
+    }
+    get owner_changed() {
+        return pb_1.Message.getWrapperField(this, ServerSent.OwnerChanged, 12) as ServerSent.OwnerChanged;
+    }
+    set owner_changed(value: ServerSent.OwnerChanged) {
+        pb_1.Message.setOneofWrapperField(this, 12, this.#one_of_decls[0], value);
+    }
+    get has_owner_changed() {
+        return pb_1.Message.getField(this, 12) != null;
+    }
+    get settings() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Settings, 13) as ServerSent.Settings;
+    }
+    set settings(value: ServerSent.Settings) {
+        pb_1.Message.setOneofWrapperField(this, 13, this.#one_of_decls[0], value);
+    }
+    get has_settings() {
+        return pb_1.Message.getField(this, 13) != null;
+    }
+    get error() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Error, 14) as ServerSent.Error;
+    }
+    set error(value: ServerSent.Error) {
+        pb_1.Message.setOneofWrapperField(this, 14, this.#one_of_decls[0], value);
+    }
+    get has_error() {
+        return pb_1.Message.getField(this, 14) != null;
+    }
+    get followed_problem() {
+        return pb_1.Message.getWrapperField(this, ServerSent.FollowedProblem, 15) as ServerSent.FollowedProblem;
+    }
+    set followed_problem(value: ServerSent.FollowedProblem) {
+        pb_1.Message.setOneofWrapperField(this, 15, this.#one_of_decls[0], value);
+    }
+    get has_followed_problem() {
+        return pb_1.Message.getField(this, 15) != null;
+    }
+    get teams() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Teams, 16) as ServerSent.Teams;
+    }
+    set teams(value: ServerSent.Teams) {
+        pb_1.Message.setOneofWrapperField(this, 16, this.#one_of_decls[0], value);
+    }
+    get has_teams() {
+        return pb_1.Message.getField(this, 16) != null;
+    }
+    get round_start() {
+        return pb_1.Message.getWrapperField(this, ServerSent.RoundStart, 17) as ServerSent.RoundStart;
+    }
+    set round_start(value: ServerSent.RoundStart) {
+        pb_1.Message.setOneofWrapperField(this, 17, this.#one_of_decls[0], value);
+    }
+    get has_round_start() {
+        return pb_1.Message.getField(this, 17) != null;
+    }
+    get round_end() {
+        return pb_1.Message.getWrapperField(this, ServerSent.RoundEnd, 18) as ServerSent.RoundEnd;
+    }
+    set round_end(value: ServerSent.RoundEnd) {
+        pb_1.Message.setOneofWrapperField(this, 18, this.#one_of_decls[0], value);
+    }
+    get has_round_end() {
+        return pb_1.Message.getField(this, 18) != null;
+    }
+    get eliminated() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Eliminated, 19) as ServerSent.Eliminated;
+    }
+    set eliminated(value: ServerSent.Eliminated) {
+        pb_1.Message.setOneofWrapperField(this, 19, this.#one_of_decls[0], value);
+    }
+    get has_eliminated() {
+        return pb_1.Message.getField(this, 19) != null;
+    }
+    get member_update() {
+        return pb_1.Message.getWrapperField(this, ServerSent.MemberUpdate, 20) as ServerSent.MemberUpdate;
+    }
+    set member_update(value: ServerSent.MemberUpdate) {
+        pb_1.Message.setOneofWrapperField(this, 20, this.#one_of_decls[0], value);
+    }
+    get has_member_update() {
+        return pb_1.Message.getField(this, 20) != null;
+    }
+    get progress() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Progress, 21) as ServerSent.Progress;
+    }
+    set progress(value: ServerSent.Progress) {
+        pb_1.Message.setOneofWrapperField(this, 21, this.#one_of_decls[0], value);
+    }
+    get has_progress() {
+        return pb_1.Message.getField(this, 21) != null;
+    }
+    get chat() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Chat, 22) as ServerSent.Chat;
+    }
+    set chat(value: ServerSent.Chat) {
+        pb_1.Message.setOneofWrapperField(this, 22, this.#one_of_decls[0], value);
+    }
+    get has_chat() {
+        return pb_1.Message.getField(this, 22) != null;
+    }
+    get scheduled() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Scheduled, 23) as ServerSent.Scheduled;
+    }
+    set scheduled(value: ServerSent.Scheduled) {
+        pb_1.Message.setOneofWrapperField(this, 23, this.#one_of_decls[0], value);
+    }
+    get has_scheduled() {
+        return pb_1.Message.getField(this, 23) != null;
+    }
+    get session() {
+        return pb_1.Message.getWrapperField(this, ServerSent.Session, 24) as ServerSent.Session;
+    }
+    set session(value: ServerSent.Session) {
+        pb_1.Message.setOneofWrapperField(this, 24, this.#one_of_decls[0], value);
+    }
+    get has_session() {
+        return pb_1.Message.getField(this, 24) != null;
+    }
+    get snapshot() {
+        return pb_1.Message.getWrapperField(this, ServerSent.LobbySnapshot, 26) as ServerSent.LobbySnapshot;
+    }
+    set snapshot(value: ServerSent.LobbySnapshot) {
+        pb_1.Message.setOneofWrapperField(this, 26, this.#one_of_decls[0], value);
+    }
+    get has_snapshot() {
+        return pb_1.Message.getField(this, 26) != null;
+    }
+    get match_round_end() {
+        return pb_1.Message.getWrapperField(this, ServerSent.MatchRoundEnd, 27) as ServerSent.MatchRoundEnd;
+    }
+    set match_round_end(value: ServerSent.MatchRoundEnd) {
+        pb_1.Message.setOneofWrapperField(this, 27, this.#one_of_decls[0], value);
+    }
+    get has_match_round_end() {
+        return pb_1.Message.getField(this, 27) != null;
+    }
+    get message() {
+        const cases: {
+            [index: number]: "none" | "remove" | "add" | "start" | "new_problem" | "end" | "score_update" | "wrong" | "rematch" | "countdown" | "paused" | "resumed" | "owner_changed" | "settings" | "error" | "followed_problem" | "teams" | "round_start" | "round_end" | "eliminated" | "member_update" | "progress" | "chat" | "scheduled" | "session" | "snapshot" | "match_round_end";
+        } = {
+            0: "none",
+            1: "remove",
+            2: "add",
+            3: "start",
+            4: "new_problem",
+            5: "end",
+            6: "score_update",
+            7: "wrong",
+            8: "rematch",
+            9: "countdown",
+            10: "paused",
+            11: "resumed",
+            12: "owner_changed",
+            13: "settings",
+            14: "error",
+            15: "followed_problem",
+            16: "teams",
+            17: "round_start",
+            18: "round_end",
+            19: "eliminated",
+            20: "member_update",
+            21: "progress",
+            22: "chat",
+            23: "scheduled",
+            24: "session",
+            26: "snapshot",
+            27: "match_round_end"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 26, 27])];
+    }
+    static fromObject(data: {
+        seq?: number;
+        remove?: ReturnType<typeof ServerSent.RemoveMember.prototype.toObject>;
+        add?: ReturnType<typeof ServerSent.AddMember.prototype.toObject>;
+        start?: ReturnType<typeof ServerSent.StartGame.prototype.toObject>;
+        new_problem?: ReturnType<typeof ServerSent.NewProblem.prototype.toObject>;
+        end?: ReturnType<typeof ServerSent.EndGame.prototype.toObject>;
+        score_update?: ReturnType<typeof ServerSent.ScoreUpdate.prototype.toObject>;
+        wrong?: ReturnType<typeof ServerSent.WrongAnswer.prototype.toObject>;
+        rematch?: ReturnType<typeof ServerSent.Rematch.prototype.toObject>;
+        countdown?: ReturnType<typeof ServerSent.Countdown.prototype.toObject>;
+        paused?: ReturnType<typeof ServerSent.Paused.prototype.toObject>;
+        resumed?: ReturnType<typeof ServerSent.Resumed.prototype.toObject>;
+        owner_changed?: ReturnType<typeof ServerSent.OwnerChanged.prototype.toObject>;
+        settings?: ReturnType<typeof ServerSent.Settings.prototype.toObject>;
+        error?: ReturnType<typeof ServerSent.Error.prototype.toObject>;
+        followed_problem?: ReturnType<typeof ServerSent.FollowedProblem.prototype.toObject>;
+        teams?: ReturnType<typeof ServerSent.Teams.prototype.toObject>;
+        round_start?: ReturnType<typeof ServerSent.RoundStart.prototype.toObject>;
+        round_end?: ReturnType<typeof ServerSent.RoundEnd.prototype.toObject>;
+        eliminated?: ReturnType<typeof ServerSent.Eliminated.prototype.toObject>;
+        member_update?: ReturnType<typeof ServerSent.MemberUpdate.prototype.toObject>;
+        progress?: ReturnType<typeof ServerSent.Progress.prototype.toObject>;
+        chat?: ReturnType<typeof ServerSent.Chat.prototype.toObject>;
+        scheduled?: ReturnType<typeof ServerSent.Scheduled.prototype.toObject>;
+        session?: ReturnType<typeof ServerSent.Session.prototype.toObject>;
+        snapshot?: ReturnType<typeof ServerSent.LobbySnapshot.prototype.toObject>;
+        match_round_end?: ReturnType<typeof ServerSent.MatchRoundEnd.prototype.toObject>;
+    }): ServerSent {
+        const message = new ServerSent({});
+        if (data.seq != null) {
+            message.seq = data.seq;
+        }
+        if (data.remove != null) {
+            message.remove = ServerSent.RemoveMember.fromObject(data.remove);
+        }
+        if (data.add != null) {
+            message.add = ServerSent.AddMember.fromObject(data.add);
+        }
+        if (data.start != null) {
+            message.start = ServerSent.StartGame.fromObject(data.start);
+        }
+        if (data.new_problem != null) {
+            message.new_problem = ServerSent.NewProblem.fromObject(data.new_problem);
+        }
+        if (data.end != null) {
+            message.end = ServerSent.EndGame.fromObject(data.end);
+        }
+        if (data.score_update != null) {
+            message.score_update = ServerSent.ScoreUpdate.fromObject(data.score_update);
+        }
+        if (data.wrong != null) {
+            message.wrong = ServerSent.WrongAnswer.fromObject(data.wrong);
+        }
+        if (data.rematch != null) {
+            message.rematch = ServerSent.Rematch.fromObject(data.rematch);
+        }
+        if (data.countdown != null) {
+            message.countdown = ServerSent.Countdown.fromObject(data.countdown);
+        }
+        if (data.paused != null) {
+            message.paused = ServerSent.Paused.fromObject(data.paused);
+        }
+        if (data.resumed != null) {
+            message.resumed = ServerSent.Resumed.fromObject(data.resumed);
+        }
+        if (data.owner_changed != null) {
+            message.owner_changed = ServerSent.OwnerChanged.fromObject(data.owner_changed);
+        }
+        if (data.settings != null) {
+            message.settings = ServerSent.Settings.fromObject(data.settings);
+        }
+        if (data.error != null) {
+            message.error = ServerSent.Error.fromObject(data.error);
+        }
+        if (data.followed_problem != null) {
+            message.followed_problem = ServerSent.FollowedProblem.fromObject(data.followed_problem);
+        }
+        if (data.teams != null) {
+            message.teams = ServerSent.Teams.fromObject(data.teams);
+        }
+        if (data.round_start != null) {
+            message.round_start = ServerSent.RoundStart.fromObject(data.round_start);
+        }
+        if (data.round_end != null) {
+            message.round_end = ServerSent.RoundEnd.fromObject(data.round_end);
+        }
+        if (data.eliminated != null) {
+            message.eliminated = ServerSent.Eliminated.fromObject(data.eliminated);
+        }
+        if (data.member_update != null) {
+            message.member_update = ServerSent.MemberUpdate.fromObject(data.member_update);
+        }
+        if (data.progress != null) {
+            message.progress = ServerSent.Progress.fromObject(data.progress);
+        }
+        if (data.chat != null) {
+            message.chat = ServerSent.Chat.fromObject(data.chat);
+        }
+        if (data.scheduled != null) {
+            message.scheduled = ServerSent.Scheduled.fromObject(data.scheduled);
+        }
+        if (data.session != null) {
+            message.session = ServerSent.Session.fromObject(data.session);
+        }
+        if (data.snapshot != null) {
+            message.snapshot = ServerSent.LobbySnapshot.fromObject(data.snapshot);
+        }
+        if (data.match_round_end != null) {
+            message.match_round_end = ServerSent.MatchRoundEnd.fromObject(data.match_round_end);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            seq?: number;
+            remove?: ReturnType<typeof ServerSent.RemoveMember.prototype.toObject>;
+            add?: ReturnType<typeof ServerSent.AddMember.prototype.toObject>;
+            start?: ReturnType<typeof ServerSent.StartGame.prototype.toObject>;
+            new_problem?: ReturnType<typeof ServerSent.NewProblem.prototype.toObject>;
+            end?: ReturnType<typeof ServerSent.EndGame.prototype.toObject>;
+            score_update?: ReturnType<typeof ServerSent.ScoreUpdate.prototype.toObject>;
+            wrong?: ReturnType<typeof ServerSent.WrongAnswer.prototype.toObject>;
+            rematch?: ReturnType<typeof ServerSent.Rematch.prototype.toObject>;
+            countdown?: ReturnType<typeof ServerSent.Countdown.prototype.toObject>;
+            paused?: ReturnType<typeof ServerSent.Paused.prototype.toObject>;
+            resumed?: ReturnType<typeof ServerSent.Resumed.prototype.toObject>;
+            owner_changed?: ReturnType<typeof ServerSent.OwnerChanged.prototype.toObject>;
+            settings?: ReturnType<typeof ServerSent.Settings.prototype.toObject>;
+            error?: ReturnType<typeof ServerSent.Error.prototype.toObject>;
+            followed_problem?: ReturnType<typeof ServerSent.FollowedProblem.prototype.toObject>;
+            teams?: ReturnType<typeof ServerSent.Teams.prototype.toObject>;
+            round_start?: ReturnType<typeof ServerSent.RoundStart.prototype.toObject>;
+            round_end?: ReturnType<typeof ServerSent.RoundEnd.prototype.toObject>;
+            eliminated?: ReturnType<typeof ServerSent.Eliminated.prototype.toObject>;
+            member_update?: ReturnType<typeof ServerSent.MemberUpdate.prototype.toObject>;
+            progress?: ReturnType<typeof ServerSent.Progress.prototype.toObject>;
+            chat?: ReturnType<typeof ServerSent.Chat.prototype.toObject>;
+            scheduled?: ReturnType<typeof ServerSent.Scheduled.prototype.toObject>;
+            session?: ReturnType<typeof ServerSent.Session.prototype.toObject>;
+            snapshot?: ReturnType<typeof ServerSent.LobbySnapshot.prototype.toObject>;
+            match_round_end?: ReturnType<typeof ServerSent.MatchRoundEnd.prototype.toObject>;
+        } = {};
+        if (this.seq != null) {
+            data.seq = this.seq;
+        }
+        if (this.remove != null) {
+            data.remove = this.remove.toObject();
+        }
+        if (this.add != null) {
+            data.add = this.add.toObject();
+        }
+        if (this.start != null) {
+            data.start = this.start.toObject();
+        }
+        if (this.new_problem != null) {
+            data.new_problem = this.new_problem.toObject();
+        }
+        if (this.end != null) {
+            data.end = this.end.toObject();
+        }
+        if (this.score_update != null) {
+            data.score_update = this.score_update.toObject();
+        }
+        if (this.wrong != null) {
+            data.wrong = this.wrong.toObject();
+        }
+        if (this.rematch != null) {
+            data.rematch = this.rematch.toObject();
+        }
+        if (this.countdown != null) {
+            data.countdown = this.countdown.toObject();
+        }
+        if (this.paused != null) {
+            data.paused = this.paused.toObject();
+        }
+        if (this.resumed != null) {
+            data.resumed = this.resumed.toObject();
+        }
+        if (this.owner_changed != null) {
+            data.owner_changed = this.owner_changed.toObject();
+        }
+        if (this.settings != null) {
+            data.settings = this.settings.toObject();
+        }
+        if (this.error != null) {
+            data.error = this.error.toObject();
+        }
+        if (this.followed_problem != null) {
+            data.followed_problem = this.followed_problem.toObject();
+        }
+        if (this.teams != null) {
+            data.teams = this.teams.toObject();
+        }
+        if (this.round_start != null) {
+            data.round_start = this.round_start.toObject();
+        }
+        if (this.round_end != null) {
+            data.round_end = this.round_end.toObject();
+        }
+        if (this.eliminated != null) {
+            data.eliminated = this.eliminated.toObject();
+        }
+        if (this.member_update != null) {
+            data.member_update = this.member_update.toObject();
+        }
+        if (this.progress != null) {
+            data.progress = this.progress.toObject();
+        }
+        if (this.chat != null) {
+            data.chat = this.chat.toObject();
+        }
+        if (this.scheduled != null) {
+            data.scheduled = this.scheduled.toObject();
+        }
+        if (this.session != null) {
+            data.session = this.session.toObject();
+        }
+        if (this.snapshot != null) {
+            data.snapshot = this.snapshot.toObject();
+        }
+        if (this.match_round_end != null) {
+            data.match_round_end = this.match_round_end.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_seq)
+            writer.writeUint64(25, this.seq);
+        if (this.has_remove)
+            writer.writeMessage(1, this.remove, () => this.remove.serialize(writer));
+        if (this.has_add)
+            writer.writeMessage(2, this.add, () => this.add.serialize(writer));
+        if (this.has_start)
+            writer.writeMessage(3, this.start, () => this.start.serialize(writer));
+        if (this.has_new_problem)
+            writer.writeMessage(4, this.new_problem, () => this.new_problem.serialize(writer));
+        if (this.has_end)
+            writer.writeMessage(5, this.end, () => this.end.serialize(writer));
+        if (this.has_score_update)
+            writer.writeMessage(6, this.score_update, () => this.score_update.serialize(writer));
+        if (this.has_wrong)
+            writer.writeMessage(7, this.wrong, () => this.wrong.serialize(writer));
+        if (this.has_rematch)
+            writer.writeMessage(8, this.rematch, () => this.rematch.serialize(writer));
+        if (this.has_countdown)
+            writer.writeMessage(9, this.countdown, () => this.countdown.serialize(writer));
+        if (this.has_paused)
+            writer.writeMessage(10, this.paused, () => this.paused.serialize(writer));
+        if (this.has_resumed)
+            writer.writeMessage(11, this.resumed, () => this.resumed.serialize(writer));
+        if (this.has_owner_changed)
+            writer.writeMessage(12, this.owner_changed, () => this.owner_changed.serialize(writer));
+        if (this.has_settings)
+            writer.writeMessage(13, this.settings, () => this.settings.serialize(writer));
+        if (this.has_error)
+            writer.writeMessage(14, this.error, () => this.error.serialize(writer));
+        if (this.has_followed_problem)
+            writer.writeMessage(15, this.followed_problem, () => this.followed_problem.serialize(writer));
+        if (this.has_teams)
+            writer.writeMessage(16, this.teams, () => this.teams.serialize(writer));
+        if (this.has_round_start)
+            writer.writeMessage(17, this.round_start, () => this.round_start.serialize(writer));
+        if (this.has_round_end)
+            writer.writeMessage(18, this.round_end, () => this.round_end.serialize(writer));
+        if (this.has_eliminated)
+            writer.writeMessage(19, this.eliminated, () => this.eliminated.serialize(writer));
+        if (this.has_member_update)
+            writer.writeMessage(20, this.member_update, () => this.member_update.serialize(writer));
+        if (this.has_progress)
+            writer.writeMessage(21, this.progress, () => this.progress.serialize(writer));
+        if (this.has_chat)
+            writer.writeMessage(22, this.chat, () => this.chat.serialize(writer));
+        if (this.has_scheduled)
+            writer.writeMessage(23, this.scheduled, () => this.scheduled.serialize(writer));
+        if (this.has_session)
+            writer.writeMessage(24, this.session, () => this.session.serialize(writer));
+        if (this.has_snapshot)
+            writer.writeMessage(26, this.snapshot, () => this.snapshot.serialize(writer));
+        if (this.has_match_round_end)
+            writer.writeMessage(27, this.match_round_end, () => this.match_round_end.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ServerSent {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ServerSent();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 25:
+                    message.seq = reader.readUint64();
+                    break;
+                case 1:
+                    reader.readMessage(message.remove, () => message.remove = ServerSent.RemoveMember.deserialize(reader));
+                    break;
+                case 2:
+                    reader.readMessage(message.add, () => message.add = ServerSent.AddMember.deserialize(reader));
+                    break;
+                case 3:
+                    reader.readMessage(message.start, () => message.start = ServerSent.StartGame.deserialize(reader));
+                    break;
+                case 4:
+                    reader.readMessage(message.new_problem, () => message.new_problem = ServerSent.NewProblem.deserialize(reader));
+                    break;
+                case 5:
+                    reader.readMessage(message.end, () => message.end = ServerSent.EndGame.deserialize(reader));
+                    break;
+                case 6:
+                    reader.readMessage(message.score_update, () => message.score_update = ServerSent.ScoreUpdate.deserialize(reader));
+                    break;
+                case 7:
+                    reader.readMessage(message.wrong, () => message.wrong = ServerSent.WrongAnswer.deserialize(reader));
+                    break;
+                case 8:
+                    reader.readMessage(message.rematch, () => message.rematch = ServerSent.Rematch.deserialize(reader));
+                    break;
+                case 9:
+                    reader.readMessage(message.countdown, () => message.countdown = ServerSent.Countdown.deserialize(reader));
+                    break;
+                case 10:
+                    reader.readMessage(message.paused, () => message.paused = ServerSent.Paused.deserialize(reader));
+                    break;
+                case 11:
+                    reader.readMessage(message.resumed, () => message.resumed = ServerSent.Resumed.deserialize(reader));
+                    break;
+                case 12:
+                    reader.readMessage(message.owner_changed, () => message.owner_changed = ServerSent.OwnerChanged.deserialize(reader));
+                    break;
+                case 13:
+                    reader.readMessage(message.settings, () => message.settings = ServerSent.Settings.deserialize(reader));
+                    break;
+                case 14:
+                    reader.readMessage(message.error, () => message.error = ServerSent.Error.deserialize(reader));
+                    break;
+                case 15:
+                    reader.readMessage(message.followed_problem, () => message.followed_problem = ServerSent.FollowedProblem.deserialize(reader));
+                    break;
+                case 16:
+                    reader.readMessage(message.teams, () => message.teams = ServerSent.Teams.deserialize(reader));
+                    break;
+                case 17:
+                    reader.readMessage(message.round_start, () => message.round_start = ServerSent.RoundStart.deserialize(reader));
+                    break;
+                case 18:
+                    reader.readMessage(message.round_end, () => message.round_end = ServerSent.RoundEnd.deserialize(reader));
+                    break;
+                case 19:
+                    reader.readMessage(message.eliminated, () => message.eliminated = ServerSent.Eliminated.deserialize(reader));
+                    break;
+                case 20:
+                    reader.readMessage(message.member_update, () => message.member_update = ServerSent.MemberUpdate.deserialize(reader));
+                    break;
+                case 21:
+                    reader.readMessage(message.progress, () => message.progress = ServerSent.Progress.deserialize(reader));
+                    break;
+                case 22:
+                    reader.readMessage(message.chat, () => message.chat = ServerSent.Chat.deserialize(reader));
+                    break;
+                case 23:
+                    reader.readMessage(message.scheduled, () => message.scheduled = ServerSent.Scheduled.deserialize(reader));
+                    break;
+                case 24:
+                    reader.readMessage(message.session, () => message.session = ServerSent.Session.deserialize(reader));
+                    break;
+                case 26:
+                    reader.readMessage(message.snapshot, () => message.snapshot = ServerSent.LobbySnapshot.deserialize(reader));
+                    break;
+                case 27:
+                    reader.readMessage(message.match_round_end, () => message.match_round_end = ServerSent.MatchRoundEnd.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ServerSent {
+        return ServerSent.deserialize(bytes);
+    }
+}
+export namespace ServerSent {
+    export class RemoveMember extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+        }): RemoveMember {
+            const message = new RemoveMember({
+                name: data.name
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveMember {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveMember();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): RemoveMember {
+            return RemoveMember.deserialize(bytes);
+        }
+    }
+    export class AddMember extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+        }): AddMember {
+            const message = new AddMember({
+                name: data.name
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AddMember {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AddMember();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): AddMember {
+            return AddMember.deserialize(bytes);
+        }
+    }
+    export class StartGame extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            startTime: dependency_1.google.protobuf.Timestamp;
+            duration: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.startTime = data.startTime;
+                this.duration = data.duration;
+            }
+        }
+        get startTime() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+        }
+        set startTime(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_startTime() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get duration() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 2) as dependency_1.google.protobuf.Timestamp;
+        }
+        set duration(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 2, value);
+        }
+        get has_duration() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        static fromObject(data: {
+            startTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): StartGame {
+            const message = new StartGame({
+                startTime: dependency_1.google.protobuf.Timestamp.fromObject(data.startTime),
+                duration: dependency_1.google.protobuf.Timestamp.fromObject(data.duration)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                startTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+                duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.startTime != null) {
+                data.startTime = this.startTime.toObject();
+            }
+            if (this.duration != null) {
+                data.duration = this.duration.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_startTime)
+                writer.writeMessage(1, this.startTime, () => this.startTime.serialize(writer));
+            if (this.has_duration)
+                writer.writeMessage(2, this.duration, () => this.duration.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): StartGame {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new StartGame();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.startTime, () => message.startTime = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    case 2:
+                        reader.readMessage(message.duration, () => message.duration = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): StartGame {
+            return StartGame.deserialize(bytes);
+        }
+    }
+    export class EndGame extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            winner?: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                if ("winner" in data && data.winner != undefined) {
+                    this.winner = data.winner;
+                }
+            }
+        }
+        get winner() {
+            return pb_1.Message.getFieldWithDefault(this, 1, "") as string;
+        }
+        set winner(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_winner() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            winner?: string;
+        }): EndGame {
+            const message = new EndGame({});
+            if (data.winner != null) {
+                message.winner = data.winner;
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                winner?: string;
+            } = {};
+            if (this.winner != null) {
+                data.winner = this.winner;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_winner && this.winner.length)
+                writer.writeString(1, this.winner);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): EndGame {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new EndGame();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.winner = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): EndGame {
+            return EndGame.deserialize(bytes);
+        }
+    }
+    export class NewProblem extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            problem: Problem;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.problem = data.problem;
+            }
+        }
+        get problem() {
+            return pb_1.Message.getWrapperField(this, Problem, 1) as Problem;
+        }
+        set problem(value: Problem) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_problem() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            problem?: ReturnType<typeof Problem.prototype.toObject>;
+        }): NewProblem {
+            const message = new NewProblem({
+                problem: Problem.fromObject(data.problem)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                problem?: ReturnType<typeof Problem.prototype.toObject>;
+            } = {};
+            if (this.problem != null) {
+                data.problem = this.problem.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_problem)
+                writer.writeMessage(1, this.problem, () => this.problem.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): NewProblem {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new NewProblem();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.problem, () => message.problem = Problem.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): NewProblem {
+            return NewProblem.deserialize(bytes);
+        }
+    }
+    export class ScoreUpdate extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+            score: number;
+            team_scores: ServerSent.ScoreUpdate.TeamScore[];
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+                this.score = data.score;
+                this.team_scores = data.team_scores;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get score() {
+            return pb_1.Message.getField(this, 2) as number;
+        }
+        set score(value: number) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get has_score() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        get team_scores() {
+            return pb_1.Message.getRepeatedWrapperField(this, ServerSent.ScoreUpdate.TeamScore, 3) as ServerSent.ScoreUpdate.TeamScore[];
+        }
+        set team_scores(value: ServerSent.ScoreUpdate.TeamScore[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 3, value);
+        }
+        static fromObject(data: {
+            name?: string;
+            score?: number;
+            team_scores?: ReturnType<typeof ServerSent.ScoreUpdate.TeamScore.prototype.toObject>[];
+        }): ScoreUpdate {
+            const message = new ScoreUpdate({
+                name: data.name,
+                score: data.score,
+                team_scores: data.team_scores.map(item => ServerSent.ScoreUpdate.TeamScore.fromObject(item))
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+                score?: number;
+                team_scores?: ReturnType<typeof ServerSent.ScoreUpdate.TeamScore.prototype.toObject>[];
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            if (this.score != null) {
+                data.score = this.score;
+            }
+            if (this.team_scores != null) {
+                data.team_scores = this.team_scores.map((item: ServerSent.ScoreUpdate.TeamScore) => item.toObject());
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (this.has_score)
+                writer.writeInt32(2, this.score);
+            if (this.team_scores.length)
+                writer.writeRepeatedMessage(3, this.team_scores, (item: ServerSent.ScoreUpdate.TeamScore) => item.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ScoreUpdate {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ScoreUpdate();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    case 2:
+                        message.score = reader.readInt32();
+                        break;
+                    case 3:
+                        reader.readMessage(message.team_scores, () => pb_1.Message.addToRepeatedWrapperField(message, 3, ServerSent.ScoreUpdate.TeamScore.deserialize(reader), ServerSent.ScoreUpdate.TeamScore));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): ScoreUpdate {
+            return ScoreUpdate.deserialize(bytes);
+        }
+    }
+    export namespace ScoreUpdate {
+        export class TeamScore extends pb_1.Message {
+            #one_of_decls: number[][] = [];
+            constructor(data?: any[] | {
+                team: string;
+                score: number;
+            }) {
+                super();
+                pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+                if (!Array.isArray(data) && typeof data == "object") {
+                    this.team = data.team;
+                    this.score = data.score;
+                }
+            }
+            get team() {
+                return pb_1.Message.getField(this, 1) as string;
+            }
+            set team(value: string) {
+                pb_1.Message.setField(this, 1, value);
+            }
+            get has_team() {
+                return pb_1.Message.getField(this, 1) != null;
+            }
+            get score() {
+                return pb_1.Message.getField(this, 2) as number;
+            }
+            set score(value: number) {
+                pb_1.Message.setField(this, 2, value);
+            }
+            get has_score() {
+                return pb_1.Message.getField(this, 2) != null;
+            }
+            static fromObject(data: {
+                team?: string;
+                score?: number;
+            }): TeamScore {
+                const message = new TeamScore({
+                    team: data.team,
+                    score: data.score
+                });
+                return message;
+            }
+            toObject() {
+                const data: {
+                    team?: string;
+                    score?: number;
+                } = {};
+                if (this.team != null) {
+                    data.team = this.team;
+                }
+                if (this.score != null) {
+                    data.score = this.score;
+                }
+                return data;
+            }
+            serialize(): Uint8Array;
+            serialize(w: pb_1.BinaryWriter): void;
+            serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+                const writer = w || new pb_1.BinaryWriter();
+                if (this.has_team && this.team.length)
+                    writer.writeString(1, this.team);
+                if (this.has_score)
+                    writer.writeInt32(2, this.score);
+                if (!w)
+                    return writer.getResultBuffer();
+            }
+            static deserialize(bytes: Uint8Array | pb_1.BinaryReader): TeamScore {
+                const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new TeamScore();
+                while (reader.nextField()) {
+                    if (reader.isEndGroup())
+                        break;
+                    switch (reader.getFieldNumber()) {
+                        case 1:
+                            message.team = reader.readString();
+                            break;
+                        case 2:
+                            message.score = reader.readInt32();
+                            break;
+                        default: reader.skipField();
+                    }
+                }
+                return message;
+            }
+            serializeBinary(): Uint8Array {
+                return this.serialize();
+            }
+            static deserializeBinary(bytes: Uint8Array): TeamScore {
+                return TeamScore.deserialize(bytes);
+            }
+        }
+    }
+    export class WrongAnswer extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {}) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") { }
+        }
+        static fromObject(data: {}): WrongAnswer {
+            const message = new WrongAnswer({});
+            return message;
+        }
+        toObject() {
+            const data: {} = {};
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): WrongAnswer {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new WrongAnswer();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): WrongAnswer {
+            return WrongAnswer.deserialize(bytes);
+        }
+    }
+    export class Rematch extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {}) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") { }
+        }
+        static fromObject(data: {}): Rematch {
+            const message = new Rematch({});
+            return message;
+        }
+        toObject() {
+            const data: {} = {};
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Rematch {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Rematch();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Rematch {
+            return Rematch.deserialize(bytes);
+        }
+    }
+    export class Countdown extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            startTime: dependency_1.google.protobuf.Timestamp;
+            serverTime: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.startTime = data.startTime;
+                this.serverTime = data.serverTime;
+            }
+        }
+        get startTime() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+        }
+        set startTime(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_startTime() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get serverTime() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 2) as dependency_1.google.protobuf.Timestamp;
+        }
+        set serverTime(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 2, value);
+        }
+        get has_serverTime() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        static fromObject(data: {
+            startTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            serverTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): Countdown {
+            const message = new Countdown({
+                startTime: dependency_1.google.protobuf.Timestamp.fromObject(data.startTime),
+                serverTime: dependency_1.google.protobuf.Timestamp.fromObject(data.serverTime)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                startTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+                serverTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.startTime != null) {
+                data.startTime = this.startTime.toObject();
+            }
+            if (this.serverTime != null) {
+                data.serverTime = this.serverTime.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_startTime)
+                writer.writeMessage(1, this.startTime, () => this.startTime.serialize(writer));
+            if (this.has_serverTime)
+                writer.writeMessage(2, this.serverTime, () => this.serverTime.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Countdown {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Countdown();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.startTime, () => message.startTime = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    case 2:
+                        reader.readMessage(message.serverTime, () => message.serverTime = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Countdown {
+            return Countdown.deserialize(bytes);
+        }
+    }
+    export class Paused extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            remaining: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.remaining = data.remaining;
+            }
+        }
+        get remaining() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+        }
+        set remaining(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_remaining() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): Paused {
+            const message = new Paused({
+                remaining: dependency_1.google.protobuf.Timestamp.fromObject(data.remaining)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.remaining != null) {
+                data.remaining = this.remaining.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_remaining)
+                writer.writeMessage(1, this.remaining, () => this.remaining.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Paused {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Paused();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.remaining, () => message.remaining = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Paused {
+            return Paused.deserialize(bytes);
+        }
+    }
+    export class Resumed extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            remaining: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.remaining = data.remaining;
+            }
+        }
+        get remaining() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+        }
+        set remaining(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_remaining() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): Resumed {
+            const message = new Resumed({
+                remaining: dependency_1.google.protobuf.Timestamp.fromObject(data.remaining)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.remaining != null) {
+                data.remaining = this.remaining.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_remaining)
+                writer.writeMessage(1, this.remaining, () => this.remaining.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Resumed {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Resumed();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.remaining, () => message.remaining = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Resumed {
+            return Resumed.deserialize(bytes);
+        }
+    }
+    export class OwnerChanged extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+        }): OwnerChanged {
+            const message = new OwnerChanged({
+                name: data.name
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): OwnerChanged {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new OwnerChanged();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): OwnerChanged {
+            return OwnerChanged.deserialize(bytes);
+        }
+    }
+    export class Settings extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            settings: LobbySettings;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.settings = data.settings;
+            }
+        }
+        get settings() {
+            return pb_1.Message.getWrapperField(this, LobbySettings, 1) as LobbySettings;
+        }
+        set settings(value: LobbySettings) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_settings() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            settings?: ReturnType<typeof LobbySettings.prototype.toObject>;
+        }): Settings {
+            const message = new Settings({
+                settings: LobbySettings.fromObject(data.settings)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                settings?: ReturnType<typeof LobbySettings.prototype.toObject>;
+            } = {};
+            if (this.settings != null) {
+                data.settings = this.settings.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_settings)
+                writer.writeMessage(1, this.settings, () => this.settings.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Settings {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Settings();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.settings, () => message.settings = LobbySettings.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Settings {
+            return Settings.deserialize(bytes);
+        }
+    }
+    export class Error extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            reason: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.reason = data.reason;
+            }
+        }
+        get reason() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set reason(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_reason() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            reason?: string;
+        }): Error {
+            const message = new Error({
+                reason: data.reason
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                reason?: string;
+            } = {};
+            if (this.reason != null) {
+                data.reason = this.reason;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_reason && this.reason.length)
+                writer.writeString(1, this.reason);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Error {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Error();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.reason = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Error {
+            return Error.deserialize(bytes);
+        }
+    }
+    export class FollowedProblem extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+            problem: Problem;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+                this.problem = data.problem;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get problem() {
+            return pb_1.Message.getWrapperField(this, Problem, 2) as Problem;
+        }
+        set problem(value: Problem) {
+            pb_1.Message.setWrapperField(this, 2, value);
+        }
+        get has_problem() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+            problem?: ReturnType<typeof Problem.prototype.toObject>;
+        }): FollowedProblem {
+            const message = new FollowedProblem({
+                name: data.name,
+                problem: Problem.fromObject(data.problem)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+                problem?: ReturnType<typeof Problem.prototype.toObject>;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            if (this.problem != null) {
+                data.problem = this.problem.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (this.has_problem)
+                writer.writeMessage(2, this.problem, () => this.problem.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): FollowedProblem {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new FollowedProblem();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    case 2:
+                        reader.readMessage(message.problem, () => message.problem = Problem.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): FollowedProblem {
+            return FollowedProblem.deserialize(bytes);
+        }
+    }
+    export class Teams extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            teams: Team[];
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.teams = data.teams;
+            }
+        }
+        get teams() {
+            return pb_1.Message.getRepeatedWrapperField(this, Team, 1) as Team[];
+        }
+        set teams(value: Team[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 1, value);
+        }
+        static fromObject(data: {
+            teams?: ReturnType<typeof Team.prototype.toObject>[];
+        }): Teams {
+            const message = new Teams({
+                teams: data.teams.map(item => Team.fromObject(item))
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                teams?: ReturnType<typeof Team.prototype.toObject>[];
+            } = {};
+            if (this.teams != null) {
+                data.teams = this.teams.map((item: Team) => item.toObject());
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.teams.length)
+                writer.writeRepeatedMessage(1, this.teams, (item: Team) => item.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Teams {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Teams();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.teams, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Team.deserialize(reader), Team));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Teams {
+            return Teams.deserialize(bytes);
+        }
+    }
+    export class RoundStart extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            round: number;
+            problem: Problem;
+            remaining?: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.round = data.round;
+                this.problem = data.problem;
+                if ("remaining" in data && data.remaining != undefined) {
+                    this.remaining = data.remaining;
+                }
+            }
+        }
+        get round() {
+            return pb_1.Message.getField(this, 1) as number;
+        }
+        set round(value: number) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_round() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get problem() {
+            return pb_1.Message.getWrapperField(this, Problem, 2) as Problem;
+        }
+        set problem(value: Problem) {
+            pb_1.Message.setWrapperField(this, 2, value);
+        }
+        get has_problem() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        get remaining() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 3) as dependency_1.google.protobuf.Timestamp;
+        }
+        set remaining(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 3, value);
+        }
+        get has_remaining() {
+            return pb_1.Message.getField(this, 3) != null;
+        }
+        static fromObject(data: {
+            round?: number;
+            problem?: ReturnType<typeof Problem.prototype.toObject>;
+            remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): RoundStart {
+            const message = new RoundStart({
+                round: data.round,
+                problem: Problem.fromObject(data.problem)
+            });
+            if (data.remaining != null) {
+                message.remaining = dependency_1.google.protobuf.Timestamp.fromObject(data.remaining);
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                round?: number;
+                problem?: ReturnType<typeof Problem.prototype.toObject>;
+                remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.round != null) {
+                data.round = this.round;
+            }
+            if (this.problem != null) {
+                data.problem = this.problem.toObject();
+            }
+            if (this.remaining != null) {
+                data.remaining = this.remaining.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_round)
+                writer.writeInt32(1, this.round);
+            if (this.has_problem)
+                writer.writeMessage(2, this.problem, () => this.problem.serialize(writer));
+            if (this.has_remaining)
+                writer.writeMessage(3, this.remaining, () => this.remaining.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RoundStart {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RoundStart();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.round = reader.readInt32();
+                        break;
+                    case 2:
+                        reader.readMessage(message.problem, () => message.problem = Problem.deserialize(reader));
+                        break;
+                    case 3:
+                        reader.readMessage(message.remaining, () => message.remaining = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): RoundStart {
+            return RoundStart.deserialize(bytes);
+        }
+    }
+    export class RoundEnd extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            round: number;
+            finishers: ServerSent.RoundEnd.Finisher[];
+            intermission: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [2], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.round = data.round;
+                this.finishers = data.finishers;
+                this.intermission = data.intermission;
+            }
+        }
+        get round() {
+            return pb_1.Message.getField(this, 1) as number;
+        }
+        set round(value: number) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_round() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get finishers() {
+            return pb_1.Message.getRepeatedWrapperField(this, ServerSent.RoundEnd.Finisher, 2) as ServerSent.RoundEnd.Finisher[];
+        }
+        set finishers(value: ServerSent.RoundEnd.Finisher[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 2, value);
+        }
+        get intermission() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 3) as dependency_1.google.protobuf.Timestamp;
+        }
+        set intermission(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 3, value);
+        }
+        get has_intermission() {
+            return pb_1.Message.getField(this, 3) != null;
+        }
+        static fromObject(data: {
+            round?: number;
+            finishers?: ReturnType<typeof ServerSent.RoundEnd.Finisher.prototype.toObject>[];
+            intermission?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): RoundEnd {
+            const message = new RoundEnd({
+                round: data.round,
+                finishers: data.finishers.map(item => ServerSent.RoundEnd.Finisher.fromObject(item)),
+                intermission: dependency_1.google.protobuf.Timestamp.fromObject(data.intermission)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                round?: number;
+                finishers?: ReturnType<typeof ServerSent.RoundEnd.Finisher.prototype.toObject>[];
+                intermission?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.round != null) {
+                data.round = this.round;
+            }
+            if (this.finishers != null) {
+                data.finishers = this.finishers.map((item: ServerSent.RoundEnd.Finisher) => item.toObject());
+            }
+            if (this.intermission != null) {
+                data.intermission = this.intermission.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_round)
+                writer.writeInt32(1, this.round);
+            if (this.finishers.length)
+                writer.writeRepeatedMessage(2, this.finishers, (item: ServerSent.RoundEnd.Finisher) => item.serialize(writer));
+            if (this.has_intermission)
+                writer.writeMessage(3, this.intermission, () => this.intermission.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RoundEnd {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RoundEnd();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.round = reader.readInt32();
+                        break;
+                    case 2:
+                        reader.readMessage(message.finishers, () => pb_1.Message.addToRepeatedWrapperField(message, 2, ServerSent.RoundEnd.Finisher.deserialize(reader), ServerSent.RoundEnd.Finisher));
+                        break;
+                    case 3:
+                        reader.readMessage(message.intermission, () => message.intermission = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): RoundEnd {
+            return RoundEnd.deserialize(bytes);
+        }
+    }
+    export namespace RoundEnd {
+        export class Finisher extends pb_1.Message {
+            #one_of_decls: number[][] = [];
+            constructor(data?: any[] | {
+                name: string;
+                position: number;
+                points: number;
+            }) {
+                super();
+                pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+                if (!Array.isArray(data) && typeof data == "object") {
+                    this.name = data.name;
+                    this.position = data.position;
+                    this.points = data.points;
+                }
+            }
+            get name() {
+                return pb_1.Message.getField(this, 1) as string;
+            }
+            set name(value: string) {
+                pb_1.Message.setField(this, 1, value);
+            }
+            get has_name() {
+                return pb_1.Message.getField(this, 1) != null;
+            }
+            get position() {
+                return pb_1.Message.getField(this, 2) as number;
+            }
+            set position(value: number) {
+                pb_1.Message.setField(this, 2, value);
+            }
+            get has_position() {
+                return pb_1.Message.getField(this, 2) != null;
+            }
+            get points() {
+                return pb_1.Message.getField(this, 3) as number;
+            }
+            set points(value: number) {
+                pb_1.Message.setField(this, 3, value);
+            }
+            get has_points() {
+                return pb_1.Message.getField(this, 3) != null;
+            }
+            static fromObject(data: {
+                name?: string;
+                position?: number;
+                points?: number;
+            }): Finisher {
+                const message = new Finisher({
+                    name: data.name,
+                    position: data.position,
+                    points: data.points
+                });
+                return message;
+            }
+            toObject() {
+                const data: {
+                    name?: string;
+                    position?: number;
+                    points?: number;
+                } = {};
+                if (this.name != null) {
+                    data.name = this.name;
+                }
+                if (this.position != null) {
+                    data.position = this.position;
+                }
+                if (this.points != null) {
+                    data.points = this.points;
+                }
+                return data;
+            }
+            serialize(): Uint8Array;
+            serialize(w: pb_1.BinaryWriter): void;
+            serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+                const writer = w || new pb_1.BinaryWriter();
+                if (this.has_name && this.name.length)
+                    writer.writeString(1, this.name);
+                if (this.has_position)
+                    writer.writeInt32(2, this.position);
+                if (this.has_points)
+                    writer.writeInt32(3, this.points);
+                if (!w)
+                    return writer.getResultBuffer();
+            }
+            static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Finisher {
+                const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Finisher();
+                while (reader.nextField()) {
+                    if (reader.isEndGroup())
+                        break;
+                    switch (reader.getFieldNumber()) {
+                        case 1:
+                            message.name = reader.readString();
+                            break;
+                        case 2:
+                            message.position = reader.readInt32();
+                            break;
+                        case 3:
+                            message.points = reader.readInt32();
+                            break;
+                        default: reader.skipField();
+                    }
+                }
+                return message;
+            }
+            serializeBinary(): Uint8Array {
+                return this.serialize();
+            }
+            static deserializeBinary(bytes: Uint8Array): Finisher {
+                return Finisher.deserialize(bytes);
+            }
+        }
+    }
+    export class MemberUpdate extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            member: Member;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.member = data.member;
+            }
+        }
+        get member() {
+            return pb_1.Message.getWrapperField(this, Member, 1) as Member;
+        }
+        set member(value: Member) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_member() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            member?: ReturnType<typeof Member.prototype.toObject>;
+        }): MemberUpdate {
+            const message = new MemberUpdate({
+                member: Member.fromObject(data.member)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                member?: ReturnType<typeof Member.prototype.toObject>;
+            } = {};
+            if (this.member != null) {
+                data.member = this.member.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_member)
+                writer.writeMessage(1, this.member, () => this.member.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): MemberUpdate {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new MemberUpdate();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.member, () => message.member = Member.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): MemberUpdate {
+            return MemberUpdate.deserialize(bytes);
+        }
+    }
+    export class Progress extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+            percent: number;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+                this.percent = data.percent;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get percent() {
+            return pb_1.Message.getField(this, 2) as number;
+        }
+        set percent(value: number) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get has_percent() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+            percent?: number;
+        }): Progress {
+            const message = new Progress({
+                name: data.name,
+                percent: data.percent
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+                percent?: number;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            if (this.percent != null) {
+                data.percent = this.percent;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (this.has_percent)
+                writer.writeInt32(2, this.percent);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Progress {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Progress();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    case 2:
+                        message.percent = reader.readInt32();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Progress {
+            return Progress.deserialize(bytes);
+        }
+    }
+    export class Chat extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+            text: string;
+            sent_at: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+                this.text = data.text;
+                this.sent_at = data.sent_at;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get text() {
+            return pb_1.Message.getField(this, 2) as string;
+        }
+        set text(value: string) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get has_text() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        get sent_at() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 3) as dependency_1.google.protobuf.Timestamp;
+        }
+        set sent_at(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 3, value);
+        }
+        get has_sent_at() {
+            return pb_1.Message.getField(this, 3) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+            text?: string;
+            sent_at?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): Chat {
+            const message = new Chat({
+                name: data.name,
+                text: data.text,
+                sent_at: dependency_1.google.protobuf.Timestamp.fromObject(data.sent_at)
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+                text?: string;
+                sent_at?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            if (this.text != null) {
+                data.text = this.text;
+            }
+            if (this.sent_at != null) {
+                data.sent_at = this.sent_at.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (this.has_text && this.text.length)
+                writer.writeString(2, this.text);
+            if (this.has_sent_at)
+                writer.writeMessage(3, this.sent_at, () => this.sent_at.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Chat {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Chat();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    case 2:
+                        message.text = reader.readString();
+                        break;
+                    case 3:
+                        reader.readMessage(message.sent_at, () => message.sent_at = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Chat {
+            return Chat.deserialize(bytes);
+        }
+    }
+    export class Scheduled extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            start_time?: dependency_1.google.protobuf.Timestamp;
+            server_time: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                if ("start_time" in data && data.start_time != undefined) {
+                    this.start_time = data.start_time;
+                }
+                this.server_time = data.server_time;
+            }
+        }
+        get start_time() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+        }
+        set start_time(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_start_time() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get server_time() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 2) as dependency_1.google.protobuf.Timestamp;
+        }
+        set server_time(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 2, value);
+        }
+        get has_server_time() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        static fromObject(data: {
+            start_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            server_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): Scheduled {
+            const message = new Scheduled({
+                server_time: dependency_1.google.protobuf.Timestamp.fromObject(data.server_time)
+            });
+            if (data.start_time != null) {
+                message.start_time = dependency_1.google.protobuf.Timestamp.fromObject(data.start_time);
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                start_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+                server_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.start_time != null) {
+                data.start_time = this.start_time.toObject();
+            }
+            if (this.server_time != null) {
+                data.server_time = this.server_time.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_start_time)
+                writer.writeMessage(1, this.start_time, () => this.start_time.serialize(writer));
+            if (this.has_server_time)
+                writer.writeMessage(2, this.server_time, () => this.server_time.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Scheduled {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Scheduled();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.start_time, () => message.start_time = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    case 2:
+                        reader.readMessage(message.server_time, () => message.server_time = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Scheduled {
+            return Scheduled.deserialize(bytes);
+        }
+    }
+    export class Eliminated extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+            place: number;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+                this.place = data.place;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get place() {
+            return pb_1.Message.getField(this, 2) as number;
+        }
+        set place(value: number) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get has_place() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+            place?: number;
+        }): Eliminated {
+            const message = new Eliminated({
+                name: data.name,
+                place: data.place
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+                place?: number;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            if (this.place != null) {
+                data.place = this.place;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (this.has_place)
+                writer.writeInt32(2, this.place);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Eliminated {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Eliminated();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    case 2:
+                        message.place = reader.readInt32();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Eliminated {
+            return Eliminated.deserialize(bytes);
+        }
+    }
+    export class Session extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            token: string;
+            resumed?: boolean;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.token = data.token;
+                if ("resumed" in data && data.resumed != undefined) {
+                    this.resumed = data.resumed;
+                }
+            }
+        }
+        get token() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set token(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_token() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get resumed() {
+            return pb_1.Message.getFieldWithDefault(this, 2, false) as boolean;
+        }
+        set resumed(value: boolean) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get has_resumed() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        static fromObject(data: {
+            token?: string;
+            resumed?: boolean;
+        }): Session {
+            const message = new Session({
+                token: data.token
+            });
+            if (data.resumed != null) {
+                message.resumed = data.resumed;
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                token?: string;
+                resumed?: boolean;
+            } = {};
+            if (this.token != null) {
+                data.token = this.token;
+            }
+            if (this.resumed != null) {
+                data.resumed = this.resumed;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_token && this.token.length)
+                writer.writeString(1, this.token);
+            if (this.has_resumed)
+                writer.writeBool(2, this.resumed);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Session {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Session();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.token = reader.readString();
+                        break;
+                    case 2:
+                        message.resumed = reader.readBool();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Session {
+            return Session.deserialize(bytes);
+        }
+    }
+    export class LobbySnapshot extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            members: Member[];
+            settings: LobbySettings;
+            owner?: string;
+            state: string;
+            remaining?: dependency_1.google.protobuf.Timestamp;
+            match_standings: Standing[];
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1, 6], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.members = data.members;
+                this.settings = data.settings;
+                if ("owner" in data && data.owner != undefined) {
+                    this.owner = data.owner;
+                }
+                this.state = data.state;
+                if ("remaining" in data && data.remaining != undefined) {
+                    this.remaining = data.remaining;
+                }
+                this.match_standings = data.match_standings;
+            }
+        }
+        get members() {
+            return pb_1.Message.getRepeatedWrapperField(this, Member, 1) as Member[];
+        }
+        set members(value: Member[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 1, value);
+        }
+        get settings() {
+            return pb_1.Message.getWrapperField(this, LobbySettings, 2) as LobbySettings;
+        }
+        set settings(value: LobbySettings) {
+            pb_1.Message.setWrapperField(this, 2, value);
+        }
+        get has_settings() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        get owner() {
+            return pb_1.Message.getFieldWithDefault(this, 3, "") as string;
+        }
+        set owner(value: string) {
+            pb_1.Message.setField(this, 3, value);
+        }
+        get has_owner() {
+            return pb_1.Message.getField(this, 3) != null;
+        }
+        get state() {
+            return pb_1.Message.getField(this, 4) as string;
+        }
+        set state(value: string) {
+            pb_1.Message.setField(this, 4, value);
+        }
+        get has_state() {
+            return pb_1.Message.getField(this, 4) != null;
+        }
+        get remaining() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 5) as dependency_1.google.protobuf.Timestamp;
+        }
+        set remaining(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 5, value);
+        }
+        get has_remaining() {
+            return pb_1.Message.getField(this, 5) != null;
+        }
+        get match_standings() {
+            return pb_1.Message.getRepeatedWrapperField(this, Standing, 6) as Standing[];
+        }
+        set match_standings(value: Standing[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 6, value);
+        }
+        static fromObject(data: {
+            members?: ReturnType<typeof Member.prototype.toObject>[];
+            settings?: ReturnType<typeof LobbySettings.prototype.toObject>;
+            owner?: string;
+            state?: string;
+            remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            match_standings?: ReturnType<typeof Standing.prototype.toObject>[];
+        }): LobbySnapshot {
+            const message = new LobbySnapshot({
+                members: data.members.map(item => Member.fromObject(item)),
+                settings: LobbySettings.fromObject(data.settings),
+                state: data.state,
+                match_standings: data.match_standings.map(item => Standing.fromObject(item))
+            });
+            if (data.owner != null) {
+                message.owner = data.owner;
+            }
+            if (data.remaining != null) {
+                message.remaining = dependency_1.google.protobuf.Timestamp.fromObject(data.remaining);
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                members?: ReturnType<typeof Member.prototype.toObject>[];
+                settings?: ReturnType<typeof LobbySettings.prototype.toObject>;
+                owner?: string;
+                state?: string;
+                remaining?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+                match_standings?: ReturnType<typeof Standing.prototype.toObject>[];
+            } = {};
+            if (this.members != null) {
+                data.members = this.members.map((item: Member) => item.toObject());
+            }
+            if (this.settings != null) {
+                data.settings = this.settings.toObject();
+            }
+            if (this.owner != null) {
+                data.owner = this.owner;
+            }
+            if (this.state != null) {
+                data.state = this.state;
+            }
+            if (this.remaining != null) {
+                data.remaining = this.remaining.toObject();
+            }
+            if (this.match_standings != null) {
+                data.match_standings = this.match_standings.map((item: Standing) => item.toObject());
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.members.length)
+                writer.writeRepeatedMessage(1, this.members, (item: Member) => item.serialize(writer));
+            if (this.has_settings)
+                writer.writeMessage(2, this.settings, () => this.settings.serialize(writer));
+            if (this.has_owner && this.owner.length)
+                writer.writeString(3, this.owner);
+            if (this.has_state && this.state.length)
+                writer.writeString(4, this.state);
+            if (this.has_remaining)
+                writer.writeMessage(5, this.remaining, () => this.remaining.serialize(writer));
+            if (this.match_standings.length)
+                writer.writeRepeatedMessage(6, this.match_standings, (item: Standing) => item.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): LobbySnapshot {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new LobbySnapshot();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.members, () => pb_1.Message.addToRepeatedWrapperField(message, 1, Member.deserialize(reader), Member));
+                        break;
+                    case 2:
+                        reader.readMessage(message.settings, () => message.settings = LobbySettings.deserialize(reader));
+                        break;
+                    case 3:
+                        message.owner = reader.readString();
+                        break;
+                    case 4:
+                        message.state = reader.readString();
+                        break;
+                    case 5:
+                        reader.readMessage(message.remaining, () => message.remaining = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    case 6:
+                        reader.readMessage(message.match_standings, () => pb_1.Message.addToRepeatedWrapperField(message, 6, Standing.deserialize(reader), Standing));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): LobbySnapshot {
+            return LobbySnapshot.deserialize(bytes);
+        }
+    }
+    export class MatchRoundEnd extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            round: number;
+            rounds: number;
+            round_standings: Standing[];
+            standings: Standing[];
+            next_round_start?: dependency_1.google.protobuf.Timestamp;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3, 4], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.round = data.round;
+                this.rounds = data.rounds;
+                this.round_standings = data.round_standings;
+                this.standings = data.standings;
+                if ("next_round_start" in data && data.next_round_start != undefined) {
+                    this.next_round_start = data.next_round_start;
+                }
+            }
+        }
+        get round() {
+            return pb_1.Message.getField(this, 1) as number;
+        }
+        set round(value: number) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_round() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get rounds() {
+            return pb_1.Message.getField(this, 2) as number;
+        }
+        set rounds(value: number) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get has_rounds() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        get round_standings() {
+            return pb_1.Message.getRepeatedWrapperField(this, Standing, 3) as Standing[];
+        }
+        set round_standings(value: Standing[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 3, value);
+        }
+        get standings() {
+            return pb_1.Message.getRepeatedWrapperField(this, Standing, 4) as Standing[];
+        }
+        set standings(value: Standing[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 4, value);
+        }
+        get next_round_start() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 5) as dependency_1.google.protobuf.Timestamp;
+        }
+        set next_round_start(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 5, value);
+        }
+        get has_next_round_start() {
+            return pb_1.Message.getField(this, 5) != null;
+        }
+        static fromObject(data: {
+            round?: number;
+            rounds?: number;
+            round_standings?: ReturnType<typeof Standing.prototype.toObject>[];
+            standings?: ReturnType<typeof Standing.prototype.toObject>[];
+            next_round_start?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): MatchRoundEnd {
+            const message = new MatchRoundEnd({
+                round: data.round,
+                rounds: data.rounds,
+                round_standings: data.round_standings.map(item => Standing.fromObject(item)),
+                standings: data.standings.map(item => Standing.fromObject(item))
+            });
+            if (data.next_round_start != null) {
+                message.next_round_start = dependency_1.google.protobuf.Timestamp.fromObject(data.next_round_start);
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                round?: number;
+                rounds?: number;
+                round_standings?: ReturnType<typeof Standing.prototype.toObject>[];
+                standings?: ReturnType<typeof Standing.prototype.toObject>[];
+                next_round_start?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            } = {};
+            if (this.round != null) {
+                data.round = this.round;
+            }
+            if (this.rounds != null) {
+                data.rounds = this.rounds;
+            }
+            if (this.round_standings != null) {
+                data.round_standings = this.round_standings.map((item: Standing) => item.toObject());
+            }
+            if (this.standings != null) {
+                data.standings = this.standings.map((item: Standing) => item.toObject());
+            }
+            if (this.next_round_start != null) {
+                data.next_round_start = this.next_round_start.toObject();
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_round)
+                writer.writeInt32(1, this.round);
+            if (this.has_rounds)
+                writer.writeInt32(2, this.rounds);
+            if (this.round_standings.length)
+                writer.writeRepeatedMessage(3, this.round_standings, (item: Standing) => item.serialize(writer));
+            if (this.standings.length)
+                writer.writeRepeatedMessage(4, this.standings, (item: Standing) => item.serialize(writer));
+            if (this.has_next_round_start)
+                writer.writeMessage(5, this.next_round_start, () => this.next_round_start.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): MatchRoundEnd {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new MatchRoundEnd();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.round = reader.readInt32();
+                        break;
+                    case 2:
+                        message.rounds = reader.readInt32();
+                        break;
+                    case 3:
+                        reader.readMessage(message.round_standings, () => pb_1.Message.addToRepeatedWrapperField(message, 3, Standing.deserialize(reader), Standing));
+                        break;
+                    case 4:
+                        reader.readMessage(message.standings, () => pb_1.Message.addToRepeatedWrapperField(message, 4, Standing.deserialize(reader), Standing));
+                        break;
+                    case 5:
+                        reader.readMessage(message.next_round_start, () => message.next_round_start = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): MatchRoundEnd {
+            return MatchRoundEnd.deserialize(bytes);
+        }
+    }
+}
+export class ClientSent extends pb_1.Message {
+    #one_of_decls: number[][] = [[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21]];
+    constructor(data?: any[] | ({} & (({
+        request_start?: ClientSent.RequestStart;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: ClientSent.GiveAnswer;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: ClientSent.RequestProblem;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: ClientSent.RequestRematch;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: ClientSent.PauseGame;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: ClientSent.ResumeGame;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: ClientSent.RequestEnd;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: ClientSent.TransferOwnership;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: ClientSent.KickPlayer;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: ClientSent.BanPlayer;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: ClientSent.UpdateSettings;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: ClientSent.Follow;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: ClientSent.DefineTeams;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: ClientSent.JoinTeam;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: ClientSent.AssignTeam;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: ClientSent.SetHandicap;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: ClientSent.Draft;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: ClientSent.Chat;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: ClientSent.SetReady;
+        schedule_start?: never;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: ClientSent.ScheduleStart;
+        set_chat_disabled?: never;
+    } | {
+        request_start?: never;
+        answer?: never;
+        request_problem?: never;
+        request_rematch?: never;
+        pause?: never;
+        resume?: never;
+        request_end?: never;
+        transfer_ownership?: never;
+        kick?: never;
+        ban?: never;
+        update_settings?: never;
+        follow?: never;
+        define_teams?: never;
+        join_team?: never;
+        assign_team?: never;
+        set_handicap?: never;
+        draft?: never;
+        chat?: never;
+        set_ready?: never;
+        schedule_start?: never;
+        set_chat_disabled?: ClientSent.SetChatDisabled;
+    })))) {
+        super();
+        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+        if (!Array.isArray(data) && typeof data == "object") {
+            if ("request_start" in data && data.request_start != undefined) {
+                this.request_start = data.request_start;
+            }
+            if ("answer" in data && data.answer != undefined) {
+                this.answer = data.answer;
+            }
+            if ("request_problem" in data && data.request_problem != undefined) {
+                this.request_problem = data.request_problem;
+            }
+            if ("request_rematch" in data && data.request_rematch != undefined) {
+                this.request_rematch = data.request_rematch;
+            }
+            if ("pause" in data && data.pause != undefined) {
+                this.pause = data.pause;
+            }
+            if ("resume" in data && data.resume != undefined) {
+                this.resume = data.resume;
+            }
+            if ("request_end" in data && data.request_end != undefined) {
+                this.request_end = data.request_end;
+            }
+            if ("transfer_ownership" in data && data.transfer_ownership != undefined) {
+                this.transfer_ownership = data.transfer_ownership;
+            }
+            if ("kick" in data && data.kick != undefined) {
+                this.kick = data.kick;
+            }
+            if ("ban" in data && data.ban != undefined) {
+                this.ban = data.ban;
+            }
+            if ("update_settings" in data && data.update_settings != undefined) {
+                this.update_settings = data.update_settings;
+            }
+            if ("follow" in data && data.follow != undefined) {
+                this.follow = data.follow;
+            }
+            if ("define_teams" in data && data.define_teams != undefined) {
+                this.define_teams = data.define_teams;
+            }
+            if ("join_team" in data && data.join_team != undefined) {
+                this.join_team = data.join_team;
+            }
+            if ("assign_team" in data && data.assign_team != undefined) {
+                this.assign_team = data.assign_team;
+            }
+            if ("set_handicap" in data && data.set_handicap != undefined) {
+                this.set_handicap = data.set_handicap;
+            }
+            if ("draft" in data && data.draft != undefined) {
+                this.draft = data.draft;
+            }
+            if ("chat" in data && data.chat != undefined) {
+                this.chat = data.chat;
+            }
+            if ("set_ready" in data && data.set_ready != undefined) {
+                this.set_ready = data.set_ready;
+            }
+            if ("schedule_start" in data && data.schedule_start != undefined) {
+                this.schedule_start = data.schedule_start;
+            }
+            if ("set_chat_disabled" in data && data.set_chat_disabled != undefined) {
+                this.set_chat_disabled = data.set_chat_disabled;
+            }
+        }
+    }
+    get request_start() {
+        return pb_1.Message.getWrapperField(this, ClientSent.RequestStart, 1) as ClientSent.RequestStart;
+    }
+    set request_start(value: ClientSent.RequestStart) {
+        pb_1.Message.setOneofWrapperField(this, 1, this.#one_of_decls[0], value);
+    }
+    get has_request_start() {
+        return pb_1.Message.getField(this, 1) != null;
+    }
+    get answer() {
+        return pb_1.Message.getWrapperField(this, ClientSent.GiveAnswer, 2) as ClientSent.GiveAnswer;
+    }
+    set answer(value: ClientSent.GiveAnswer) {
+        pb_1.Message.setOneofWrapperField(this, 2, this.#one_of_decls[0], value);
+    }
+    get has_answer() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get request_problem() {
+        return pb_1.Message.getWrapperField(this, ClientSent.RequestProblem, 3) as ClientSent.RequestProblem;
+    }
+    set request_problem(value: ClientSent.RequestProblem) {
+        pb_1.Message.setOneofWrapperField(this, 3, this.#one_of_decls[0], value);
+    }
+    get has_request_problem() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
+    get request_rematch() {
+        return pb_1.Message.getWrapperField(this, ClientSent.RequestRematch, 4) as ClientSent.RequestRematch;
+    }
+    set request_rematch(value: ClientSent.RequestRematch) {
+        pb_1.Message.setOneofWrapperField(this, 4, this.#one_of_decls[0], value);
+    }
+    get has_request_rematch() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
+    get pause() {
+        return pb_1.Message.getWrapperField(this, ClientSent.PauseGame, 5) as ClientSent.PauseGame;
+    }
+    set pause(value: ClientSent.PauseGame) {
+        pb_1.Message.setOneofWrapperField(this, 5, this.#one_of_decls[0], value);
+    }
+    get has_pause() {
+        return pb_1.Message.getField(this, 5) != null;
+    }
+    get resume() {
+        return pb_1.Message.getWrapperField(this, ClientSent.ResumeGame, 6) as ClientSent.ResumeGame;
+    }
+    set resume(value: ClientSent.ResumeGame) {
+        pb_1.Message.setOneofWrapperField(this, 6, this.#one_of_decls[0], value);
+    }
+    get has_resume() {
+        return pb_1.Message.getField(this, 6) != null;
+    }
+    get request_end() {
+        return pb_1.Message.getWrapperField(this, ClientSent.RequestEnd, 7) as ClientSent.RequestEnd;
+    }
+    set request_end(value: ClientSent.RequestEnd) {
+        pb_1.Message.setOneofWrapperField(this, 7, this.#one_of_decls[0], value);
+    }
+    get has_request_end() {
+        return pb_1.Message.getField(this, 7) != null;
+    }
+    get transfer_ownership() {
+        return pb_1.Message.getWrapperField(this, ClientSent.TransferOwnership, 8) as ClientSent.TransferOwnership;
+    }
+    set transfer_ownership(value: ClientSent.TransferOwnership) {
+        pb_1.Message.setOneofWrapperField(this, 8, this.#one_of_decls[0], value);
+    }
+    get has_transfer_ownership() {
+        return pb_1.Message.getField(this, 8) != null;
+    }
+    get kick() {
+        return pb_1.Message.getWrapperField(this, ClientSent.KickPlayer, 9) as ClientSent.KickPlayer;
+    }
+    set kick(value: ClientSent.KickPlayer) {
+        pb_1.Message.setOneofWrapperField(this, 9, this.#one_of_decls[0], value);
+    }
+    get has_kick() {
+        return pb_1.Message.getField(this, 9) != null;
+    }
+    get ban() {
+        return pb_1.Message.getWrapperField(this, ClientSent.BanPlayer, 10) as ClientSent.BanPlayer;
+    }
+    set ban(value: ClientSent.BanPlayer) {
+        pb_1.Message.setOneofWrapperField(this, 10, this.#one_of_decls[0], value);
+    }
+    get has_ban() {
+        return pb_1.Message.getField(this, 10) != null;
+    }
+    get update_settings() {
+        return pb_1.Message.getWrapperField(this, ClientSent.UpdateSettings, 11) as ClientSent.UpdateSettings;
+    }
+    set update_settings(value: ClientSent.UpdateSettings) {
+        pb_1.Message.setOneofWrapperField(this, 11, this.#one_of_decls[0], value);
+    }
+    get has_update_settings() {
+        return pb_1.Message.getField(this, 11) != null;
+    }
+    get follow() {
+        return pb_1.Message.getWrapperField(this, ClientSent.Follow, 12) as ClientSent.Follow;
+    }
+    set follow(value: ClientSent.Follow) {
+        pb_1.Message.setOneofWrapperField(this, 12, this.#one_of_decls[0], value);
+    }
+    get has_follow() {
+        return pb_1.Message.getField(this, 12) != null;
+    }
+    get define_teams() {
+        return pb_1.Message.getWrapperField(this, ClientSent.DefineTeams, 13) as ClientSent.DefineTeams;
+    }
+    set define_teams(value: ClientSent.DefineTeams) {
+        pb_1.Message.setOneofWrapperField(this, 13, this.#one_of_decls[0], value);
+    }
+    get has_define_teams() {
+        return pb_1.Message.getField(this, 13) != null;
+    }
+    get join_team() {
+        return pb_1.Message.getWrapperField(this, ClientSent.JoinTeam, 14) as ClientSent.JoinTeam;
+    }
+    set join_team(value: ClientSent.JoinTeam) {
+        pb_1.Message.setOneofWrapperField(this, 14, this.#one_of_decls[0], value);
+    }
+    get has_join_team() {
+        return pb_1.Message.getField(this, 14) != null;
+    }
+    get assign_team() {
+        return pb_1.Message.getWrapperField(this, ClientSent.AssignTeam, 15) as ClientSent.AssignTeam;
+    }
+    set assign_team(value: ClientSent.AssignTeam) {
+        pb_1.Message.setOneofWrapperField(this, 15, this.#one_of_decls[0], value);
+    }
+    get has_assign_team() {
+        return pb_1.Message.getField(this, 15) != null;
+    }
+    get set_handicap() {
+        return pb_1.Message.getWrapperField(this, ClientSent.SetHandicap, 16) as ClientSent.SetHandicap;
+    }
+    set set_handicap(value: ClientSent.SetHandicap) {
+        pb_1.Message.setOneofWrapperField(this, 16, this.#one_of_decls[0], value);
+    }
+    get has_set_handicap() {
+        return pb_1.Message.getField(this, 16) != null;
+    }
+    get draft() {
+        return pb_1.Message.getWrapperField(this, ClientSent.Draft, 17) as ClientSent.Draft;
+    }
+    set draft(value: ClientSent.Draft) {
+        pb_1.Message.setOneofWrapperField(this, 17, this.#one_of_decls[0], value);
+    }
+    get has_draft() {
+        return pb_1.Message.getField(this, 17) != null;
+    }
+    get chat() {
+        return pb_1.Message.getWrapperField(this, ClientSent.Chat, 18) as ClientSent.Chat;
+    }
+    set chat(value: ClientSent.Chat) {
+        pb_1.Message.setOneofWrapperField(this, 18, this.#one_of_decls[0], value);
+    }
+    get has_chat() {
+        return pb_1.Message.getField(this, 18) != null;
+    }
+    get set_ready() {
+        return pb_1.Message.getWrapperField(this, ClientSent.SetReady, 19) as ClientSent.SetReady;
+    }
+    set set_ready(value: ClientSent.SetReady) {
+        pb_1.Message.setOneofWrapperField(this, 19, this.#one_of_decls[0], value);
+    }
+    get has_set_ready() {
+        return pb_1.Message.getField(this, 19) != null;
+    }
+    get schedule_start() {
+        return pb_1.Message.getWrapperField(this, ClientSent.ScheduleStart, 20) as ClientSent.ScheduleStart;
+    }
+    set schedule_start(value: ClientSent.ScheduleStart) {
+        pb_1.Message.setOneofWrapperField(this, 20, this.#one_of_decls[0], value);
+    }
+    get has_schedule_start() {
+        return pb_1.Message.getField(this, 20) != null;
+    }
+    get set_chat_disabled() {
+        return pb_1.Message.getWrapperField(this, ClientSent.SetChatDisabled, 21) as ClientSent.SetChatDisabled;
+    }
+    set set_chat_disabled(value: ClientSent.SetChatDisabled) {
+        pb_1.Message.setOneofWrapperField(this, 21, this.#one_of_decls[0], value);
+    }
+    get has_set_chat_disabled() {
+        return pb_1.Message.getField(this, 21) != null;
+    }
+    get message() {
+        const cases: {
+            [index: number]: "none" | "request_start" | "answer" | "request_problem" | "request_rematch" | "pause" | "resume" | "request_end" | "transfer_ownership" | "kick" | "ban" | "update_settings" | "follow" | "define_teams" | "join_team" | "assign_team" | "set_handicap" | "draft" | "chat" | "set_ready" | "schedule_start" | "set_chat_disabled";
+        } = {
+            0: "none",
+            1: "request_start",
+            2: "answer",
+            3: "request_problem",
+            4: "request_rematch",
+            5: "pause",
+            6: "resume",
+            7: "request_end",
+            8: "transfer_ownership",
+            9: "kick",
+            10: "ban",
+            11: "update_settings",
+            12: "follow",
+            13: "define_teams",
+            14: "join_team",
+            15: "assign_team",
+            16: "set_handicap",
+            17: "draft",
+            18: "chat",
+            19: "set_ready",
+            20: "schedule_start",
+            21: "set_chat_disabled"
+        };
+        return cases[pb_1.Message.computeOneofCase(this, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21])];
+    }
+    static fromObject(data: {
+        request_start?: ReturnType<typeof ClientSent.RequestStart.prototype.toObject>;
+        answer?: ReturnType<typeof ClientSent.GiveAnswer.prototype.toObject>;
+        request_problem?: ReturnType<typeof ClientSent.RequestProblem.prototype.toObject>;
+        request_rematch?: ReturnType<typeof ClientSent.RequestRematch.prototype.toObject>;
+        pause?: ReturnType<typeof ClientSent.PauseGame.prototype.toObject>;
+        resume?: ReturnType<typeof ClientSent.ResumeGame.prototype.toObject>;
+        request_end?: ReturnType<typeof ClientSent.RequestEnd.prototype.toObject>;
+        transfer_ownership?: ReturnType<typeof ClientSent.TransferOwnership.prototype.toObject>;
+        kick?: ReturnType<typeof ClientSent.KickPlayer.prototype.toObject>;
+        ban?: ReturnType<typeof ClientSent.BanPlayer.prototype.toObject>;
+        update_settings?: ReturnType<typeof ClientSent.UpdateSettings.prototype.toObject>;
+        follow?: ReturnType<typeof ClientSent.Follow.prototype.toObject>;
+        define_teams?: ReturnType<typeof ClientSent.DefineTeams.prototype.toObject>;
+        join_team?: ReturnType<typeof ClientSent.JoinTeam.prototype.toObject>;
+        assign_team?: ReturnType<typeof ClientSent.AssignTeam.prototype.toObject>;
+        set_handicap?: ReturnType<typeof ClientSent.SetHandicap.prototype.toObject>;
+        draft?: ReturnType<typeof ClientSent.Draft.prototype.toObject>;
+        chat?: ReturnType<typeof ClientSent.Chat.prototype.toObject>;
+        set_ready?: ReturnType<typeof ClientSent.SetReady.prototype.toObject>;
+        schedule_start?: ReturnType<typeof ClientSent.ScheduleStart.prototype.toObject>;
+        set_chat_disabled?: ReturnType<typeof ClientSent.SetChatDisabled.prototype.toObject>;
+    }): ClientSent {
+        const message = new ClientSent({});
+        if (data.request_start != null) {
+            message.request_start = ClientSent.RequestStart.fromObject(data.request_start);
+        }
+        if (data.answer != null) {
+            message.answer = ClientSent.GiveAnswer.fromObject(data.answer);
+        }
+        if (data.request_problem != null) {
+            message.request_problem = ClientSent.RequestProblem.fromObject(data.request_problem);
+        }
+        if (data.request_rematch != null) {
+            message.request_rematch = ClientSent.RequestRematch.fromObject(data.request_rematch);
+        }
+        if (data.pause != null) {
+            message.pause = ClientSent.PauseGame.fromObject(data.pause);
+        }
+        if (data.resume != null) {
+            message.resume = ClientSent.ResumeGame.fromObject(data.resume);
+        }
+        if (data.request_end != null) {
+            message.request_end = ClientSent.RequestEnd.fromObject(data.request_end);
+        }
+        if (data.transfer_ownership != null) {
+            message.transfer_ownership = ClientSent.TransferOwnership.fromObject(data.transfer_ownership);
+        }
+        if (data.kick != null) {
+            message.kick = ClientSent.KickPlayer.fromObject(data.kick);
+        }
+        if (data.ban != null) {
+            message.ban = ClientSent.BanPlayer.fromObject(data.ban);
+        }
+        if (data.update_settings != null) {
+            message.update_settings = ClientSent.UpdateSettings.fromObject(data.update_settings);
+        }
+        if (data.follow != null) {
+            message.follow = ClientSent.Follow.fromObject(data.follow);
+        }
+        if (data.define_teams != null) {
+            message.define_teams = ClientSent.DefineTeams.fromObject(data.define_teams);
+        }
+        if (data.join_team != null) {
+            message.join_team = ClientSent.JoinTeam.fromObject(data.join_team);
+        }
+        if (data.assign_team != null) {
+            message.assign_team = ClientSent.AssignTeam.fromObject(data.assign_team);
+        }
+        if (data.set_handicap != null) {
+            message.set_handicap = ClientSent.SetHandicap.fromObject(data.set_handicap);
+        }
+        if (data.draft != null) {
+            message.draft = ClientSent.Draft.fromObject(data.draft);
+        }
+        if (data.chat != null) {
+            message.chat = ClientSent.Chat.fromObject(data.chat);
+        }
+        if (data.set_ready != null) {
+            message.set_ready = ClientSent.SetReady.fromObject(data.set_ready);
+        }
+        if (data.schedule_start != null) {
+            message.schedule_start = ClientSent.ScheduleStart.fromObject(data.schedule_start);
+        }
+        if (data.set_chat_disabled != null) {
+            message.set_chat_disabled = ClientSent.SetChatDisabled.fromObject(data.set_chat_disabled);
+        }
+        return message;
+    }
+    toObject() {
+        const data: {
+            request_start?: ReturnType<typeof ClientSent.RequestStart.prototype.toObject>;
+            answer?: ReturnType<typeof ClientSent.GiveAnswer.prototype.toObject>;
+            request_problem?: ReturnType<typeof ClientSent.RequestProblem.prototype.toObject>;
+            request_rematch?: ReturnType<typeof ClientSent.RequestRematch.prototype.toObject>;
+            pause?: ReturnType<typeof ClientSent.PauseGame.prototype.toObject>;
+            resume?: ReturnType<typeof ClientSent.ResumeGame.prototype.toObject>;
+            request_end?: ReturnType<typeof ClientSent.RequestEnd.prototype.toObject>;
+            transfer_ownership?: ReturnType<typeof ClientSent.TransferOwnership.prototype.toObject>;
+            kick?: ReturnType<typeof ClientSent.KickPlayer.prototype.toObject>;
+            ban?: ReturnType<typeof ClientSent.BanPlayer.prototype.toObject>;
+            update_settings?: ReturnType<typeof ClientSent.UpdateSettings.prototype.toObject>;
+            follow?: ReturnType<typeof ClientSent.Follow.prototype.toObject>;
+            define_teams?: ReturnType<typeof ClientSent.DefineTeams.prototype.toObject>;
+            join_team?: ReturnType<typeof ClientSent.JoinTeam.prototype.toObject>;
+            assign_team?: ReturnType<typeof ClientSent.AssignTeam.prototype.toObject>;
+            set_handicap?: ReturnType<typeof ClientSent.SetHandicap.prototype.toObject>;
+            draft?: ReturnType<typeof ClientSent.Draft.prototype.toObject>;
+            chat?: ReturnType<typeof ClientSent.Chat.prototype.toObject>;
+            set_ready?: ReturnType<typeof ClientSent.SetReady.prototype.toObject>;
+            schedule_start?: ReturnType<typeof ClientSent.ScheduleStart.prototype.toObject>;
+            set_chat_disabled?: ReturnType<typeof ClientSent.SetChatDisabled.prototype.toObject>;
+        } = {};
+        if (this.request_start != null) {
+            data.request_start = this.request_start.toObject();
+        }
+        if (this.answer != null) {
+            data.answer = this.answer.toObject();
+        }
+        if (this.request_problem != null) {
+            data.request_problem = this.request_problem.toObject();
+        }
+        if (this.request_rematch != null) {
+            data.request_rematch = this.request_rematch.toObject();
+        }
+        if (this.pause != null) {
+            data.pause = this.pause.toObject();
+        }
+        if (this.resume != null) {
+            data.resume = this.resume.toObject();
+        }
+        if (this.request_end != null) {
+            data.request_end = this.request_end.toObject();
+        }
+        if (this.transfer_ownership != null) {
+            data.transfer_ownership = this.transfer_ownership.toObject();
+        }
+        if (this.kick != null) {
+            data.kick = this.kick.toObject();
+        }
+        if (this.ban != null) {
+            data.ban = this.ban.toObject();
+        }
+        if (this.update_settings != null) {
+            data.update_settings = this.update_settings.toObject();
+        }
+        if (this.follow != null) {
+            data.follow = this.follow.toObject();
+        }
+        if (this.define_teams != null) {
+            data.define_teams = this.define_teams.toObject();
+        }
+        if (this.join_team != null) {
+            data.join_team = this.join_team.toObject();
+        }
+        if (this.assign_team != null) {
+            data.assign_team = this.assign_team.toObject();
+        }
+        if (this.set_handicap != null) {
+            data.set_handicap = this.set_handicap.toObject();
+        }
+        if (this.draft != null) {
+            data.draft = this.draft.toObject();
+        }
+        if (this.chat != null) {
+            data.chat = this.chat.toObject();
+        }
+        if (this.set_ready != null) {
+            data.set_ready = this.set_ready.toObject();
+        }
+        if (this.schedule_start != null) {
+            data.schedule_start = this.schedule_start.toObject();
+        }
+        if (this.set_chat_disabled != null) {
+            data.set_chat_disabled = this.set_chat_disabled.toObject();
+        }
+        return data;
+    }
+    serialize(): Uint8Array;
+    serialize(w: pb_1.BinaryWriter): void;
+    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+        const writer = w || new pb_1.BinaryWriter();
+        if (this.has_request_start)
+            writer.writeMessage(1, this.request_start, () => this.request_start.serialize(writer));
+        if (this.has_answer)
+            writer.writeMessage(2, this.answer, () => this.answer.serialize(writer));
+        if (this.has_request_problem)
+            writer.writeMessage(3, this.request_problem, () => this.request_problem.serialize(writer));
+        if (this.has_request_rematch)
+            writer.writeMessage(4, this.request_rematch, () => this.request_rematch.serialize(writer));
+        if (this.has_pause)
+            writer.writeMessage(5, this.pause, () => this.pause.serialize(writer));
+        if (this.has_resume)
+            writer.writeMessage(6, this.resume, () => this.resume.serialize(writer));
+        if (this.has_request_end)
+            writer.writeMessage(7, this.request_end, () => this.request_end.serialize(writer));
+        if (this.has_transfer_ownership)
+            writer.writeMessage(8, this.transfer_ownership, () => this.transfer_ownership.serialize(writer));
+        if (this.has_kick)
+            writer.writeMessage(9, this.kick, () => this.kick.serialize(writer));
+        if (this.has_ban)
+            writer.writeMessage(10, this.ban, () => this.ban.serialize(writer));
+        if (this.has_update_settings)
+            writer.writeMessage(11, this.update_settings, () => this.update_settings.serialize(writer));
+        if (this.has_follow)
+            writer.writeMessage(12, this.follow, () => this.follow.serialize(writer));
+        if (this.has_define_teams)
+            writer.writeMessage(13, this.define_teams, () => this.define_teams.serialize(writer));
+        if (this.has_join_team)
+            writer.writeMessage(14, this.join_team, () => this.join_team.serialize(writer));
+        if (this.has_assign_team)
+            writer.writeMessage(15, this.assign_team, () => this.assign_team.serialize(writer));
+        if (this.has_set_handicap)
+            writer.writeMessage(16, this.set_handicap, () => this.set_handicap.serialize(writer));
+        if (this.has_draft)
+            writer.writeMessage(17, this.draft, () => this.draft.serialize(writer));
+        if (this.has_chat)
+            writer.writeMessage(18, this.chat, () => this.chat.serialize(writer));
+        if (this.has_set_ready)
+            writer.writeMessage(19, this.set_ready, () => this.set_ready.serialize(writer));
+        if (this.has_schedule_start)
+            writer.writeMessage(20, this.schedule_start, () => this.schedule_start.serialize(writer));
+        if (this.has_set_chat_disabled)
+            writer.writeMessage(21, this.set_chat_disabled, () => this.set_chat_disabled.serialize(writer));
+        if (!w)
+            return writer.getResultBuffer();
+    }
+    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ClientSent {
+        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ClientSent();
+        while (reader.nextField()) {
+            if (reader.isEndGroup())
+                break;
+            switch (reader.getFieldNumber()) {
+                case 1:
+                    reader.readMessage(message.request_start, () => message.request_start = ClientSent.RequestStart.deserialize(reader));
+                    break;
+                case 2:
+                    reader.readMessage(message.answer, () => message.answer = ClientSent.GiveAnswer.deserialize(reader));
+                    break;
+                case 3:
+                    reader.readMessage(message.request_problem, () => message.request_problem = ClientSent.RequestProblem.deserialize(reader));
+                    break;
+                case 4:
+                    reader.readMessage(message.request_rematch, () => message.request_rematch = ClientSent.RequestRematch.deserialize(reader));
+                    break;
+                case 5:
+                    reader.readMessage(message.pause, () => message.pause = ClientSent.PauseGame.deserialize(reader));
+                    break;
+                case 6:
+                    reader.readMessage(message.resume, () => message.resume = ClientSent.ResumeGame.deserialize(reader));
+                    break;
+                case 7:
+                    reader.readMessage(message.request_end, () => message.request_end = ClientSent.RequestEnd.deserialize(reader));
+                    break;
+                case 8:
+                    reader.readMessage(message.transfer_ownership, () => message.transfer_ownership = ClientSent.TransferOwnership.deserialize(reader));
+                    break;
+                case 9:
+                    reader.readMessage(message.kick, () => message.kick = ClientSent.KickPlayer.deserialize(reader));
+                    break;
+                case 10:
+                    reader.readMessage(message.ban, () => message.ban = ClientSent.BanPlayer.deserialize(reader));
+                    break;
+                case 11:
+                    reader.readMessage(message.update_settings, () => message.update_settings = ClientSent.UpdateSettings.deserialize(reader));
+                    break;
+                case 12:
+                    reader.readMessage(message.follow, () => message.follow = ClientSent.Follow.deserialize(reader));
+                    break;
+                case 13:
+                    reader.readMessage(message.define_teams, () => message.define_teams = ClientSent.DefineTeams.deserialize(reader));
+                    break;
+                case 14:
+                    reader.readMessage(message.join_team, () => message.join_team = ClientSent.JoinTeam.deserialize(reader));
+                    break;
+                case 15:
+                    reader.readMessage(message.assign_team, () => message.assign_team = ClientSent.AssignTeam.deserialize(reader));
+                    break;
+                case 16:
+                    reader.readMessage(message.set_handicap, () => message.set_handicap = ClientSent.SetHandicap.deserialize(reader));
+                    break;
+                case 17:
+                    reader.readMessage(message.draft, () => message.draft = ClientSent.Draft.deserialize(reader));
+                    break;
+                case 18:
+                    reader.readMessage(message.chat, () => message.chat = ClientSent.Chat.deserialize(reader));
+                    break;
+                case 19:
+                    reader.readMessage(message.set_ready, () => message.set_ready = ClientSent.SetReady.deserialize(reader));
+                    break;
+                case 20:
+                    reader.readMessage(message.schedule_start, () => message.schedule_start = ClientSent.ScheduleStart.deserialize(reader));
+                    break;
+                case 21:
+                    reader.readMessage(message.set_chat_disabled, () => message.set_chat_disabled = ClientSent.SetChatDisabled.deserialize(reader));
+                    break;
+                default: reader.skipField();
+            }
+        }
+        return message;
+    }
+    serializeBinary(): Uint8Array {
+        return this.serialize();
+    }
+    static deserializeBinary(bytes: Uint8Array): ClientSent {
+        return ClientSent.deserialize(bytes);
+    }
+}
+export namespace ClientSent {
+    export class RequestStart extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            duration?: dependency_1.google.protobuf.Timestamp;
+            is_random?: boolean;
+            problems: Problem[];
+            countdown?: dependency_1.google.protobuf.Timestamp;
+            force?: boolean;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                if ("duration" in data && data.duration != undefined) {
+                    this.duration = data.duration;
+                }
+                if ("is_random" in data && data.is_random != undefined) {
+                    this.is_random = data.is_random;
+                }
+                this.problems = data.problems;
+                if ("countdown" in data && data.countdown != undefined) {
+                    this.countdown = data.countdown;
+                }
+                if ("force" in data && data.force != undefined) {
+                    this.force = data.force;
+                }
+            }
+        }
+        get duration() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+        }
+        set duration(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 1, value);
+        }
+        get has_duration() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        get is_random() {
+            return pb_1.Message.getFieldWithDefault(this, 2, false) as boolean;
+        }
+        set is_random(value: boolean) {
+            pb_1.Message.setField(this, 2, value);
+        }
+        get has_is_random() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
+        get problems() {
+            return pb_1.Message.getRepeatedWrapperField(this, Problem, 3) as Problem[];
+        }
+        set problems(value: Problem[]) {
+            pb_1.Message.setRepeatedWrapperField(this, 3, value);
+        }
+        get countdown() {
+            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 4) as dependency_1.google.protobuf.Timestamp;
+        }
+        set countdown(value: dependency_1.google.protobuf.Timestamp) {
+            pb_1.Message.setWrapperField(this, 4, value);
+        }
+        get has_countdown() {
+            return pb_1.Message.getField(this, 4) != null;
+        }
+        get force() {
+            return pb_1.Message.getFieldWithDefault(this, 5, false) as boolean;
+        }
+        set force(value: boolean) {
+            pb_1.Message.setField(this, 5, value);
+        }
+        get has_force() {
+            return pb_1.Message.getField(this, 5) != null;
+        }
+        static fromObject(data: {
+            duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            is_random?: boolean;
+            problems?: ReturnType<typeof Problem.prototype.toObject>[];
+            countdown?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+            force?: boolean;
+        }): RequestStart {
+            const message = new RequestStart({
+                problems: data.problems.map(item => Problem.fromObject(item))
+            });
+            if (data.duration != null) {
+                message.duration = dependency_1.google.protobuf.Timestamp.fromObject(data.duration);
+            }
+            if (data.is_random != null) {
+                message.is_random = data.is_random;
+            }
+            if (data.countdown != null) {
+                message.countdown = dependency_1.google.protobuf.Timestamp.fromObject(data.countdown);
+            }
+            if (data.force != null) {
+                message.force = data.force;
+            }
+            return message;
+        }
+        toObject() {
+            const data: {
+                duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+                is_random?: boolean;
+                problems?: ReturnType<typeof Problem.prototype.toObject>[];
+                countdown?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+                force?: boolean;
+            } = {};
+            if (this.duration != null) {
+                data.duration = this.duration.toObject();
+            }
+            if (this.is_random != null) {
+                data.is_random = this.is_random;
+            }
+            if (this.problems != null) {
+                data.problems = this.problems.map((item: Problem) => item.toObject());
+            }
+            if (this.countdown != null) {
+                data.countdown = this.countdown.toObject();
+            }
+            if (this.force != null) {
+                data.force = this.force;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_duration)
+                writer.writeMessage(1, this.duration, () => this.duration.serialize(writer));
+            if (this.has_is_random)
+                writer.writeBool(2, this.is_random);
+            if (this.problems.length)
+                writer.writeRepeatedMessage(3, this.problems, (item: Problem) => item.serialize(writer));
+            if (this.has_countdown)
+                writer.writeMessage(4, this.countdown, () => this.countdown.serialize(writer));
+            if (this.has_force)
+                writer.writeBool(5, this.force);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestStart {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestStart();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.duration, () => message.duration = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    case 2:
+                        message.is_random = reader.readBool();
+                        break;
+                    case 3:
+                        reader.readMessage(message.problems, () => pb_1.Message.addToRepeatedWrapperField(message, 3, Problem.deserialize(reader), Problem));
+                        break;
+                    case 4:
+                        reader.readMessage(message.countdown, () => message.countdown = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        break;
+                    case 5:
+                        message.force = reader.readBool();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): RequestStart {
+            return RequestStart.deserialize(bytes);
+        }
+    }
+    export class GiveAnswer extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            answer: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.answer = data.answer;
+            }
+        }
+        get answer() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set answer(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_answer() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            answer?: string;
+        }): GiveAnswer {
+            const message = new GiveAnswer({
+                answer: data.answer
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                answer?: string;
+            } = {};
+            if (this.answer != null) {
+                data.answer = this.answer;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_answer && this.answer.length)
+                writer.writeString(1, this.answer);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GiveAnswer {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GiveAnswer();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.answer = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): GiveAnswer {
+            return GiveAnswer.deserialize(bytes);
+        }
+    }
+    export class RequestProblem extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {}) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") { }
+        }
+        static fromObject(data: {}): RequestProblem {
+            const message = new RequestProblem({});
+            return message;
+        }
+        toObject() {
+            const data: {} = {};
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestProblem {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestProblem();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): RequestProblem {
+            return RequestProblem.deserialize(bytes);
+        }
+    }
+    export class RequestRematch extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {}) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") { }
+        }
+        static fromObject(data: {}): RequestRematch {
+            const message = new RequestRematch({});
+            return message;
+        }
+        toObject() {
+            const data: {} = {};
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestRematch {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestRematch();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): RequestRematch {
+            return RequestRematch.deserialize(bytes);
+        }
+    }
+    export class PauseGame extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {}) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") { }
+        }
+        static fromObject(data: {}): PauseGame {
+            const message = new PauseGame({});
+            return message;
+        }
+        toObject() {
+            const data: {} = {};
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): PauseGame {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new PauseGame();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): PauseGame {
+            return PauseGame.deserialize(bytes);
+        }
+    }
+    export class ResumeGame extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {}) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") { }
+        }
+        static fromObject(data: {}): ResumeGame {
+            const message = new ResumeGame({});
+            return message;
+        }
+        toObject() {
+            const data: {} = {};
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ResumeGame {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ResumeGame();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): ResumeGame {
+            return ResumeGame.deserialize(bytes);
+        }
+    }
+    export class RequestEnd extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {}) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") { }
+        }
+        static fromObject(data: {}): RequestEnd {
+            const message = new RequestEnd({});
+            return message;
+        }
+        toObject() {
+            const data: {} = {};
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestEnd {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestEnd();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): RequestEnd {
+            return RequestEnd.deserialize(bytes);
+        }
+    }
+    export class TransferOwnership extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
         set name(value: string) {
             pb_1.Message.setField(this, 1, value);
         }
@@ -428,8 +7179,8 @@ export namespace ServerSent {
         }
         static fromObject(data: {
             name?: string;
-        }): RemoveMember {
-            const message = new RemoveMember({
+        }): TransferOwnership {
+            const message = new TransferOwnership({
                 name: data.name
             });
             return message;
@@ -452,8 +7203,8 @@ export namespace ServerSent {
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RemoveMember {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RemoveMember();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): TransferOwnership {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new TransferOwnership();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
@@ -469,11 +7220,11 @@ export namespace ServerSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): RemoveMember {
-            return RemoveMember.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): TransferOwnership {
+            return TransferOwnership.deserialize(bytes);
         }
     }
-    export class AddMember extends pb_1.Message {
+    export class KickPlayer extends pb_1.Message {
         #one_of_decls: number[][] = [];
         constructor(data?: any[] | {
             name: string;
@@ -495,8 +7246,8 @@ export namespace ServerSent {
         }
         static fromObject(data: {
             name?: string;
-        }): AddMember {
-            const message = new AddMember({
+        }): KickPlayer {
+            const message = new KickPlayer({
                 name: data.name
             });
             return message;
@@ -519,8 +7270,8 @@ export namespace ServerSent {
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AddMember {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AddMember();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): KickPlayer {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new KickPlayer();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
@@ -536,61 +7287,178 @@ export namespace ServerSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): AddMember {
-            return AddMember.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): KickPlayer {
+            return KickPlayer.deserialize(bytes);
         }
     }
-    export class StartGame extends pb_1.Message {
+    export class BanPlayer extends pb_1.Message {
         #one_of_decls: number[][] = [];
         constructor(data?: any[] | {
-            startTime: dependency_1.google.protobuf.Timestamp;
-            duration: dependency_1.google.protobuf.Timestamp;
+            name: string;
         }) {
             super();
             pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
             if (!Array.isArray(data) && typeof data == "object") {
-                this.startTime = data.startTime;
-                this.duration = data.duration;
+                this.name = data.name;
             }
         }
-        get startTime() {
-            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
         }
-        set startTime(value: dependency_1.google.protobuf.Timestamp) {
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            name?: string;
+        }): BanPlayer {
+            const message = new BanPlayer({
+                name: data.name
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                name?: string;
+            } = {};
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): BanPlayer {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new BanPlayer();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.name = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): BanPlayer {
+            return BanPlayer.deserialize(bytes);
+        }
+    }
+    export class UpdateSettings extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            settings: LobbySettings;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.settings = data.settings;
+            }
+        }
+        get settings() {
+            return pb_1.Message.getWrapperField(this, LobbySettings, 1) as LobbySettings;
+        }
+        set settings(value: LobbySettings) {
             pb_1.Message.setWrapperField(this, 1, value);
         }
-        get has_startTime() {
+        get has_settings() {
             return pb_1.Message.getField(this, 1) != null;
         }
-        get duration() {
-            return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 2) as dependency_1.google.protobuf.Timestamp;
+        static fromObject(data: {
+            settings?: ReturnType<typeof LobbySettings.prototype.toObject>;
+        }): UpdateSettings {
+            const message = new UpdateSettings({
+                settings: LobbySettings.fromObject(data.settings)
+            });
+            return message;
         }
-        set duration(value: dependency_1.google.protobuf.Timestamp) {
-            pb_1.Message.setWrapperField(this, 2, value);
+        toObject() {
+            const data: {
+                settings?: ReturnType<typeof LobbySettings.prototype.toObject>;
+            } = {};
+            if (this.settings != null) {
+                data.settings = this.settings.toObject();
+            }
+            return data;
         }
-        get has_duration() {
-            return pb_1.Message.getField(this, 2) != null;
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_settings)
+                writer.writeMessage(1, this.settings, () => this.settings.serialize(writer));
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): UpdateSettings {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new UpdateSettings();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        reader.readMessage(message.settings, () => message.settings = LobbySettings.deserialize(reader));
+                        break;
+                    default: reader.skipField();
+                }
+            }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): UpdateSettings {
+            return UpdateSettings.deserialize(bytes);
+        }
+    }
+    export class Follow extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            name: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.name = data.name;
+            }
+        }
+        get name() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set name(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_name() {
+            return pb_1.Message.getField(this, 1) != null;
         }
         static fromObject(data: {
-            startTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
-            duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
-        }): StartGame {
-            const message = new StartGame({
-                startTime: dependency_1.google.protobuf.Timestamp.fromObject(data.startTime),
-                duration: dependency_1.google.protobuf.Timestamp.fromObject(data.duration)
+            name?: string;
+        }): Follow {
+            const message = new Follow({
+                name: data.name
             });
             return message;
         }
         toObject() {
             const data: {
-                startTime?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
-                duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+                name?: string;
             } = {};
-            if (this.startTime != null) {
-                data.startTime = this.startTime.toObject();
-            }
-            if (this.duration != null) {
-                data.duration = this.duration.toObject();
+            if (this.name != null) {
+                data.name = this.name;
             }
             return data;
         }
@@ -598,24 +7466,19 @@ export namespace ServerSent {
         serialize(w: pb_1.BinaryWriter): void;
         serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
             const writer = w || new pb_1.BinaryWriter();
-            if (this.has_startTime)
-                writer.writeMessage(1, this.startTime, () => this.startTime.serialize(writer));
-            if (this.has_duration)
-                writer.writeMessage(2, this.duration, () => this.duration.serialize(writer));
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): StartGame {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new StartGame();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Follow {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Follow();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
                 switch (reader.getFieldNumber()) {
                     case 1:
-                        reader.readMessage(message.startTime, () => message.startTime = dependency_1.google.protobuf.Timestamp.deserialize(reader));
-                        break;
-                    case 2:
-                        reader.readMessage(message.duration, () => message.duration = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                        message.name = reader.readString();
                         break;
                     default: reader.skipField();
                 }
@@ -625,38 +7488,62 @@ export namespace ServerSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): StartGame {
-            return StartGame.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): Follow {
+            return Follow.deserialize(bytes);
         }
     }
-    export class EndGame extends pb_1.Message {
+    export class DefineTeams extends pb_1.Message {
         #one_of_decls: number[][] = [];
-        constructor(data?: any[] | {}) {
+        constructor(data?: any[] | {
+            names: string[];
+        }) {
             super();
-            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-            if (!Array.isArray(data) && typeof data == "object") { }
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [1], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.names = data.names;
+            }
         }
-        static fromObject(data: {}): EndGame {
-            const message = new EndGame({});
+        get names() {
+            return pb_1.Message.getFieldWithDefault(this, 1, []) as string[];
+        }
+        set names(value: string[]) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        static fromObject(data: {
+            names?: string[];
+        }): DefineTeams {
+            const message = new DefineTeams({
+                names: data.names
+            });
             return message;
         }
         toObject() {
-            const data: {} = {};
+            const data: {
+                names?: string[];
+            } = {};
+            if (this.names != null) {
+                data.names = this.names;
+            }
             return data;
         }
         serialize(): Uint8Array;
         serialize(w: pb_1.BinaryWriter): void;
         serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
             const writer = w || new pb_1.BinaryWriter();
+            if (this.names.length)
+                writer.writeRepeatedString(1, this.names);
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): EndGame {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new EndGame();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): DefineTeams {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new DefineTeams();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
                 switch (reader.getFieldNumber()) {
+                    case 1:
+                        pb_1.Message.addToRepeatedField(message, 1, reader.readString());
+                        break;
                     default: reader.skipField();
                 }
             }
@@ -665,44 +7552,44 @@ export namespace ServerSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): EndGame {
-            return EndGame.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): DefineTeams {
+            return DefineTeams.deserialize(bytes);
         }
     }
-    export class NewProblem extends pb_1.Message {
+    export class JoinTeam extends pb_1.Message {
         #one_of_decls: number[][] = [];
         constructor(data?: any[] | {
-            problem: Problem;
+            team: string;
         }) {
             super();
             pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
             if (!Array.isArray(data) && typeof data == "object") {
-                this.problem = data.problem;
+                this.team = data.team;
             }
         }
-        get problem() {
-            return pb_1.Message.getWrapperField(this, Problem, 1) as Problem;
+        get team() {
+            return pb_1.Message.getField(this, 1) as string;
         }
-        set problem(value: Problem) {
-            pb_1.Message.setWrapperField(this, 1, value);
+        set team(value: string) {
+            pb_1.Message.setField(this, 1, value);
         }
-        get has_problem() {
+        get has_team() {
             return pb_1.Message.getField(this, 1) != null;
         }
         static fromObject(data: {
-            problem?: ReturnType<typeof Problem.prototype.toObject>;
-        }): NewProblem {
-            const message = new NewProblem({
-                problem: Problem.fromObject(data.problem)
+            team?: string;
+        }): JoinTeam {
+            const message = new JoinTeam({
+                team: data.team
             });
             return message;
         }
         toObject() {
             const data: {
-                problem?: ReturnType<typeof Problem.prototype.toObject>;
+                team?: string;
             } = {};
-            if (this.problem != null) {
-                data.problem = this.problem.toObject();
+            if (this.team != null) {
+                data.team = this.team;
             }
             return data;
         }
@@ -710,19 +7597,19 @@ export namespace ServerSent {
         serialize(w: pb_1.BinaryWriter): void;
         serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
             const writer = w || new pb_1.BinaryWriter();
-            if (this.has_problem)
-                writer.writeMessage(1, this.problem, () => this.problem.serialize(writer));
+            if (this.has_team && this.team.length)
+                writer.writeString(1, this.team);
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): NewProblem {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new NewProblem();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): JoinTeam {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new JoinTeam();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
                 switch (reader.getFieldNumber()) {
                     case 1:
-                        reader.readMessage(message.problem, () => message.problem = Problem.deserialize(reader));
+                        message.team = reader.readString();
                         break;
                     default: reader.skipField();
                 }
@@ -732,21 +7619,21 @@ export namespace ServerSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): NewProblem {
-            return NewProblem.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): JoinTeam {
+            return JoinTeam.deserialize(bytes);
         }
     }
-    export class ScoreUpdate extends pb_1.Message {
+    export class AssignTeam extends pb_1.Message {
         #one_of_decls: number[][] = [];
         constructor(data?: any[] | {
             name: string;
-            score: number;
+            team: string;
         }) {
             super();
             pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
             if (!Array.isArray(data) && typeof data == "object") {
                 this.name = data.name;
-                this.score = data.score;
+                this.team = data.team;
             }
         }
         get name() {
@@ -758,35 +7645,35 @@ export namespace ServerSent {
         get has_name() {
             return pb_1.Message.getField(this, 1) != null;
         }
-        get score() {
-            return pb_1.Message.getField(this, 2) as number;
+        get team() {
+            return pb_1.Message.getField(this, 2) as string;
         }
-        set score(value: number) {
+        set team(value: string) {
             pb_1.Message.setField(this, 2, value);
         }
-        get has_score() {
+        get has_team() {
             return pb_1.Message.getField(this, 2) != null;
         }
         static fromObject(data: {
             name?: string;
-            score?: number;
-        }): ScoreUpdate {
-            const message = new ScoreUpdate({
+            team?: string;
+        }): AssignTeam {
+            const message = new AssignTeam({
                 name: data.name,
-                score: data.score
+                team: data.team
             });
             return message;
         }
         toObject() {
             const data: {
                 name?: string;
-                score?: number;
+                team?: string;
             } = {};
             if (this.name != null) {
                 data.name = this.name;
             }
-            if (this.score != null) {
-                data.score = this.score;
+            if (this.team != null) {
+                data.team = this.team;
             }
             return data;
         }
@@ -796,13 +7683,13 @@ export namespace ServerSent {
             const writer = w || new pb_1.BinaryWriter();
             if (this.has_name && this.name.length)
                 writer.writeString(1, this.name);
-            if (this.has_score)
-                writer.writeInt32(2, this.score);
+            if (this.has_team && this.team.length)
+                writer.writeString(2, this.team);
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ScoreUpdate {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ScoreUpdate();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): AssignTeam {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new AssignTeam();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
@@ -811,7 +7698,7 @@ export namespace ServerSent {
                         message.name = reader.readString();
                         break;
                     case 2:
-                        message.score = reader.readInt32();
+                        message.team = reader.readString();
                         break;
                     default: reader.skipField();
                 }
@@ -821,38 +7708,65 @@ export namespace ServerSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): ScoreUpdate {
-            return ScoreUpdate.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): AssignTeam {
+            return AssignTeam.deserialize(bytes);
         }
     }
-    export class WrongAnswer extends pb_1.Message {
+    export class Draft extends pb_1.Message {
         #one_of_decls: number[][] = [];
-        constructor(data?: any[] | {}) {
+        constructor(data?: any[] | {
+            answer: string;
+        }) {
             super();
             pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-            if (!Array.isArray(data) && typeof data == "object") { }
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.answer = data.answer;
+            }
         }
-        static fromObject(data: {}): WrongAnswer {
-            const message = new WrongAnswer({});
+        get answer() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set answer(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_answer() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            answer?: string;
+        }): Draft {
+            const message = new Draft({
+                answer: data.answer
+            });
             return message;
         }
         toObject() {
-            const data: {} = {};
+            const data: {
+                answer?: string;
+            } = {};
+            if (this.answer != null) {
+                data.answer = this.answer;
+            }
             return data;
         }
         serialize(): Uint8Array;
         serialize(w: pb_1.BinaryWriter): void;
         serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
             const writer = w || new pb_1.BinaryWriter();
+            if (this.has_answer && this.answer.length)
+                writer.writeString(1, this.answer);
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): WrongAnswer {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new WrongAnswer();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Draft {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Draft();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
                 switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.answer = reader.readString();
+                        break;
                     default: reader.skipField();
                 }
             }
@@ -861,218 +7775,181 @@ export namespace ServerSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): WrongAnswer {
-            return WrongAnswer.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): Draft {
+            return Draft.deserialize(bytes);
         }
     }
-}
-export class ClientSent extends pb_1.Message {
-    #one_of_decls: number[][] = [[1, 2, 3]];
-    constructor(data?: any[] | ({} & (({
-        request_start?: ClientSent.RequestStart;
-        answer?: never;
-        request_problem?: never;
-    } | {
-        request_start?: never;
-        answer?: ClientSent.GiveAnswer;
-        request_problem?: never;
-    } | {
-        request_start?: never;
-        answer?: never;
-        request_problem?: ClientSent.RequestProblem;
-    })))) {
-        super();
-        pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-        if (!Array.isArray(data) && typeof data == "object") {
-            if ("request_start" in data && data.request_start != undefined) {
-                this.request_start = data.request_start;
+    export class Chat extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            text: string;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.text = data.text;
             }
-            if ("answer" in data && data.answer != undefined) {
-                this.answer = data.answer;
+        }
+        get text() {
+            return pb_1.Message.getField(this, 1) as string;
+        }
+        set text(value: string) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_text() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            text?: string;
+        }): Chat {
+            const message = new Chat({
+                text: data.text
+            });
+            return message;
+        }
+        toObject() {
+            const data: {
+                text?: string;
+            } = {};
+            if (this.text != null) {
+                data.text = this.text;
             }
-            if ("request_problem" in data && data.request_problem != undefined) {
-                this.request_problem = data.request_problem;
+            return data;
+        }
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_text && this.text.length)
+                writer.writeString(1, this.text);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): Chat {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new Chat();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
+                    break;
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.text = reader.readString();
+                        break;
+                    default: reader.skipField();
+                }
             }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): Chat {
+            return Chat.deserialize(bytes);
         }
     }
-    get request_start() {
-        return pb_1.Message.getWrapperField(this, ClientSent.RequestStart, 1) as ClientSent.RequestStart;
-    }
-    set request_start(value: ClientSent.RequestStart) {
-        pb_1.Message.setOneofWrapperField(this, 1, this.#one_of_decls[0], value);
-    }
-    get has_request_start() {
-        return pb_1.Message.getField(this, 1) != null;
-    }
-    get answer() {
-        return pb_1.Message.getWrapperField(this, ClientSent.GiveAnswer, 2) as ClientSent.GiveAnswer;
-    }
-    set answer(value: ClientSent.GiveAnswer) {
-        pb_1.Message.setOneofWrapperField(this, 2, this.#one_of_decls[0], value);
-    }
-    get has_answer() {
-        return pb_1.Message.getField(this, 2) != null;
-    }
-    get request_problem() {
-        return pb_1.Message.getWrapperField(this, ClientSent.RequestProblem, 3) as ClientSent.RequestProblem;
-    }
-    set request_problem(value: ClientSent.RequestProblem) {
-        pb_1.Message.setOneofWrapperField(this, 3, this.#one_of_decls[0], value);
-    }
-    get has_request_problem() {
-        return pb_1.Message.getField(this, 3) != null;
-    }
-    get message() {
-        const cases: {
-            [index: number]: "none" | "request_start" | "answer" | "request_problem";
-        } = {
-            0: "none",
-            1: "request_start",
-            2: "answer",
-            3: "request_problem"
-        };
-        return cases[pb_1.Message.computeOneofCase(this, [1, 2, 3])];
-    }
-    static fromObject(data: {
-        request_start?: ReturnType<typeof ClientSent.RequestStart.prototype.toObject>;
-        answer?: ReturnType<typeof ClientSent.GiveAnswer.prototype.toObject>;
-        request_problem?: ReturnType<typeof ClientSent.RequestProblem.prototype.toObject>;
-    }): ClientSent {
-        const message = new ClientSent({});
-        if (data.request_start != null) {
-            message.request_start = ClientSent.RequestStart.fromObject(data.request_start);
+    export class SetReady extends pb_1.Message {
+        #one_of_decls: number[][] = [];
+        constructor(data?: any[] | {
+            ready: boolean;
+        }) {
+            super();
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.ready = data.ready;
+            }
         }
-        if (data.answer != null) {
-            message.answer = ClientSent.GiveAnswer.fromObject(data.answer);
+        get ready() {
+            return pb_1.Message.getField(this, 1) as boolean;
         }
-        if (data.request_problem != null) {
-            message.request_problem = ClientSent.RequestProblem.fromObject(data.request_problem);
+        set ready(value: boolean) {
+            pb_1.Message.setField(this, 1, value);
         }
-        return message;
-    }
-    toObject() {
-        const data: {
-            request_start?: ReturnType<typeof ClientSent.RequestStart.prototype.toObject>;
-            answer?: ReturnType<typeof ClientSent.GiveAnswer.prototype.toObject>;
-            request_problem?: ReturnType<typeof ClientSent.RequestProblem.prototype.toObject>;
-        } = {};
-        if (this.request_start != null) {
-            data.request_start = this.request_start.toObject();
+        get has_ready() {
+            return pb_1.Message.getField(this, 1) != null;
         }
-        if (this.answer != null) {
-            data.answer = this.answer.toObject();
+        static fromObject(data: {
+            ready?: boolean;
+        }): SetReady {
+            const message = new SetReady({
+                ready: data.ready
+            });
+            return message;
         }
-        if (this.request_problem != null) {
-            data.request_problem = this.request_problem.toObject();
+        toObject() {
+            const data: {
+                ready?: boolean;
+            } = {};
+            if (this.ready != null) {
+                data.ready = this.ready;
+            }
+            return data;
         }
-        return data;
-    }
-    serialize(): Uint8Array;
-    serialize(w: pb_1.BinaryWriter): void;
-    serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
-        const writer = w || new pb_1.BinaryWriter();
-        if (this.has_request_start)
-            writer.writeMessage(1, this.request_start, () => this.request_start.serialize(writer));
-        if (this.has_answer)
-            writer.writeMessage(2, this.answer, () => this.answer.serialize(writer));
-        if (this.has_request_problem)
-            writer.writeMessage(3, this.request_problem, () => this.request_problem.serialize(writer));
-        if (!w)
-            return writer.getResultBuffer();
-    }
-    static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ClientSent {
-        const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ClientSent();
-        while (reader.nextField()) {
-            if (reader.isEndGroup())
-                break;
-            switch (reader.getFieldNumber()) {
-                case 1:
-                    reader.readMessage(message.request_start, () => message.request_start = ClientSent.RequestStart.deserialize(reader));
-                    break;
-                case 2:
-                    reader.readMessage(message.answer, () => message.answer = ClientSent.GiveAnswer.deserialize(reader));
-                    break;
-                case 3:
-                    reader.readMessage(message.request_problem, () => message.request_problem = ClientSent.RequestProblem.deserialize(reader));
+        serialize(): Uint8Array;
+        serialize(w: pb_1.BinaryWriter): void;
+        serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
+            const writer = w || new pb_1.BinaryWriter();
+            if (this.has_ready)
+                writer.writeBool(1, this.ready);
+            if (!w)
+                return writer.getResultBuffer();
+        }
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SetReady {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SetReady();
+            while (reader.nextField()) {
+                if (reader.isEndGroup())
                     break;
-                default: reader.skipField();
+                switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.ready = reader.readBool();
+                        break;
+                    default: reader.skipField();
+                }
             }
+            return message;
+        }
+        serializeBinary(): Uint8Array {
+            return this.serialize();
+        }
+        static deserializeBinary(bytes: Uint8Array): SetReady {
+            return SetReady.deserialize(bytes);
         }
-        return message;
-    }
-    serializeBinary(): Uint8Array {
-        return this.serialize();
-    }
-    static deserializeBinary(bytes: Uint8Array): ClientSent {
-        return ClientSent.deserialize(bytes);
     }
-}
-export namespace ClientSent {
-    export class RequestStart extends pb_1.Message {
+    export class ScheduleStart extends pb_1.Message {
         #one_of_decls: number[][] = [];
         constructor(data?: any[] | {
-            duration: dependency_1.google.protobuf.Timestamp;
-            is_random: boolean;
-            problems: Problem[];
+            start_time?: dependency_1.google.protobuf.Timestamp;
         }) {
             super();
-            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [3], this.#one_of_decls);
+            pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
             if (!Array.isArray(data) && typeof data == "object") {
-                this.duration = data.duration;
-                this.is_random = data.is_random;
-                this.problems = data.problems;
+                if ("start_time" in data && data.start_time != undefined) {
+                    this.start_time = data.start_time;
+                }
             }
         }
-        get duration() {
+        get start_time() {
             return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 1) as dependency_1.google.protobuf.Timestamp;
         }
-        set duration(value: dependency_1.google.protobuf.Timestamp) {
+        set start_time(value: dependency_1.google.protobuf.Timestamp) {
             pb_1.Message.setWrapperField(this, 1, value);
         }
-        get has_duration() {
+        get has_start_time() {
             return pb_1.Message.getField(this, 1) != null;
         }
-        get is_random() {
-            return pb_1.Message.getField(this, 2) as boolean;
-        }
-        set is_random(value: boolean) {
-            pb_1.Message.setField(this, 2, value);
-        }
-        get has_is_random() {
-            return pb_1.Message.getField(this, 2) != null;
-        }
-        get problems() {
-            return pb_1.Message.getRepeatedWrapperField(this, Problem, 3) as Problem[];
-        }
-        set problems(value: Problem[]) {
-            pb_1.Message.setRepeatedWrapperField(this, 3, value);
-        }
         static fromObject(data: {
-            duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
-            is_random?: boolean;
-            problems?: ReturnType<typeof Problem.prototype.toObject>[];
-        }): RequestStart {
-            const message = new RequestStart({
-                duration: dependency_1.google.protobuf.Timestamp.fromObject(data.duration),
-                is_random: data.is_random,
-                problems: data.problems.map(item => Problem.fromObject(item))
-            });
+            start_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
+        }): ScheduleStart {
+            const message = new ScheduleStart({});
+            if (data.start_time != null) {
+                message.start_time = dependency_1.google.protobuf.Timestamp.fromObject(data.start_time);
+            }
             return message;
         }
         toObject() {
             const data: {
-                duration?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
-                is_random?: boolean;
-                problems?: ReturnType<typeof Problem.prototype.toObject>[];
+                start_time?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
             } = {};
-            if (this.duration != null) {
-                data.duration = this.duration.toObject();
-            }
-            if (this.is_random != null) {
-                data.is_random = this.is_random;
-            }
-            if (this.problems != null) {
-                data.problems = this.problems.map((item: Problem) => item.toObject());
+            if (this.start_time != null) {
+                data.start_time = this.start_time.toObject();
             }
             return data;
         }
@@ -1080,29 +7957,19 @@ export namespace ClientSent {
         serialize(w: pb_1.BinaryWriter): void;
         serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
             const writer = w || new pb_1.BinaryWriter();
-            if (this.has_duration)
-                writer.writeMessage(1, this.duration, () => this.duration.serialize(writer));
-            if (this.has_is_random)
-                writer.writeBool(2, this.is_random);
-            if (this.problems.length)
-                writer.writeRepeatedMessage(3, this.problems, (item: Problem) => item.serialize(writer));
+            if (this.has_start_time)
+                writer.writeMessage(1, this.start_time, () => this.start_time.serialize(writer));
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestStart {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestStart();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): ScheduleStart {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new ScheduleStart();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
                 switch (reader.getFieldNumber()) {
                     case 1:
-                        reader.readMessage(message.duration, () => message.duration = dependency_1.google.protobuf.Timestamp.deserialize(reader));
-                        break;
-                    case 2:
-                        message.is_random = reader.readBool();
-                        break;
-                    case 3:
-                        reader.readMessage(message.problems, () => pb_1.Message.addToRepeatedWrapperField(message, 3, Problem.deserialize(reader), Problem));
+                        reader.readMessage(message.start_time, () => message.start_time = dependency_1.google.protobuf.Timestamp.deserialize(reader));
                         break;
                     default: reader.skipField();
                 }
@@ -1112,44 +7979,61 @@ export namespace ClientSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): RequestStart {
-            return RequestStart.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): ScheduleStart {
+            return ScheduleStart.deserialize(bytes);
         }
     }
-    export class GiveAnswer extends pb_1.Message {
+    export class SetHandicap extends pb_1.Message {
         #one_of_decls: number[][] = [];
         constructor(data?: any[] | {
-            answer: string;
+            name: string;
+            handicap: Handicap;
         }) {
             super();
             pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
             if (!Array.isArray(data) && typeof data == "object") {
-                this.answer = data.answer;
+                this.name = data.name;
+                this.handicap = data.handicap;
             }
         }
-        get answer() {
+        get name() {
             return pb_1.Message.getField(this, 1) as string;
         }
-        set answer(value: string) {
+        set name(value: string) {
             pb_1.Message.setField(this, 1, value);
         }
-        get has_answer() {
+        get has_name() {
             return pb_1.Message.getField(this, 1) != null;
         }
+        get handicap() {
+            return pb_1.Message.getWrapperField(this, Handicap, 2) as Handicap;
+        }
+        set handicap(value: Handicap) {
+            pb_1.Message.setWrapperField(this, 2, value);
+        }
+        get has_handicap() {
+            return pb_1.Message.getField(this, 2) != null;
+        }
         static fromObject(data: {
-            answer?: string;
-        }): GiveAnswer {
-            const message = new GiveAnswer({
-                answer: data.answer
+            name?: string;
+            handicap?: ReturnType<typeof Handicap.prototype.toObject>;
+        }): SetHandicap {
+            const message = new SetHandicap({
+                name: data.name,
+                handicap: Handicap.fromObject(data.handicap)
             });
             return message;
         }
         toObject() {
             const data: {
-                answer?: string;
+                name?: string;
+                handicap?: ReturnType<typeof Handicap.prototype.toObject>;
             } = {};
-            if (this.answer != null) {
-                data.answer = this.answer;
+            if (this.name != null) {
+                data.name = this.name;
+            }
+            if (this.handicap != null) {
+                data.handicap = this.handicap.toObject();
             }
             return data;
         }
@@ -1157,19 +8041,24 @@ export namespace ClientSent {
         serialize(w: pb_1.BinaryWriter): void;
         serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
             const writer = w || new pb_1.BinaryWriter();
-            if (this.has_answer && this.answer.length)
-                writer.writeString(1, this.answer);
+            if (this.has_name && this.name.length)
+                writer.writeString(1, this.name);
+            if (this.has_handicap)
+                writer.writeMessage(2, this.handicap, () => this.handicap.serialize(writer));
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): GiveAnswer {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new GiveAnswer();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SetHandicap {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SetHandicap();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
                 switch (reader.getFieldNumber()) {
                     case 1:
-                        message.answer = reader.readString();
+                        message.name = reader.readString();
+                        break;
+                    case 2:
+                        reader.readMessage(message.handicap, () => message.handicap = Handicap.deserialize(reader));
                         break;
                     default: reader.skipField();
                 }
@@ -1179,38 +8068,65 @@ export namespace ClientSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): GiveAnswer {
-            return GiveAnswer.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): SetHandicap {
+            return SetHandicap.deserialize(bytes);
         }
     }
-    export class RequestProblem extends pb_1.Message {
+    export class SetChatDisabled extends pb_1.Message {
         #one_of_decls: number[][] = [];
-        constructor(data?: any[] | {}) {
+        constructor(data?: any[] | {
+            disabled: boolean;
+        }) {
             super();
             pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
-            if (!Array.isArray(data) && typeof data == "object") { }
+            if (!Array.isArray(data) && typeof data == "object") {
+                this.disabled = data.disabled;
+            }
         }
-        static fromObject(data: {}): RequestProblem {
-            const message = new RequestProblem({});
+        get disabled() {
+            return pb_1.Message.getField(this, 1) as boolean;
+        }
+        set disabled(value: boolean) {
+            pb_1.Message.setField(this, 1, value);
+        }
+        get has_disabled() {
+            return pb_1.Message.getField(this, 1) != null;
+        }
+        static fromObject(data: {
+            disabled?: boolean;
+        }): SetChatDisabled {
+            const message = new SetChatDisabled({
+                disabled: data.disabled
+            });
             return message;
         }
         toObject() {
-            const data: {} = {};
+            const data: {
+                disabled?: boolean;
+            } = {};
+            if (this.disabled != null) {
+                data.disabled = this.disabled;
+            }
             return data;
         }
         serialize(): Uint8Array;
         serialize(w: pb_1.BinaryWriter): void;
         serialize(w?: pb_1.BinaryWriter): Uint8Array | void {
             const writer = w || new pb_1.BinaryWriter();
+            if (this.has_disabled)
+                writer.writeBool(1, this.disabled);
             if (!w)
                 return writer.getResultBuffer();
         }
-        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): RequestProblem {
-            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new RequestProblem();
+        static deserialize(bytes: Uint8Array | pb_1.BinaryReader): SetChatDisabled {
+            const reader = bytes instanceof pb_1.BinaryReader ? bytes : new pb_1.BinaryReader(bytes), message = new SetChatDisabled();
             while (reader.nextField()) {
                 if (reader.isEndGroup())
                     break;
                 switch (reader.getFieldNumber()) {
+                    case 1:
+                        message.disabled = reader.readBool();
+                        break;
                     default: reader.skipField();
                 }
             }
@@ -1219,8 +8135,8 @@ export namespace ClientSent {
         serializeBinary(): Uint8Array {
             return this.serialize();
         }
-        static deserializeBinary(bytes: Uint8Array): RequestProblem {
-            return RequestProblem.deserialize(bytes);
+        static deserializeBinary(bytes: Uint8Array): SetChatDisabled {
+            return SetChatDisabled.deserialize(bytes);
         }
     }
 }
@@ -1228,11 +8144,19 @@ export class CreateLobbyReq extends pb_1.Message {
     #one_of_decls: number[][] = [];
     constructor(data?: any[] | {
         lobby_name: string;
+        is_public?: boolean;
+        scheduled_start?: dependency_1.google.protobuf.Timestamp;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
             this.lobby_name = data.lobby_name;
+            if ("is_public" in data && data.is_public != undefined) {
+                this.is_public = data.is_public;
+            }
+            if ("scheduled_start" in data && data.scheduled_start != undefined) {
+                this.scheduled_start = data.scheduled_start;
+            }
         }
     }
     get lobby_name() {
@@ -1244,21 +8168,55 @@ export class CreateLobbyReq extends pb_1.Message {
     get has_lobby_name() {
         return pb_1.Message.getField(this, 1) != null;
     }
+    get is_public() {
+        return pb_1.Message.getFieldWithDefault(this, 2, false) as boolean;
+    }
+    set is_public(value: boolean) {
+        pb_1.Message.setField(this, 2, value);
+    }
+    get has_is_public() {
+        return pb_1.Message.getField(this, 2) != null;
+    }
+    get scheduled_start() {
+        return pb_1.Message.getWrapperField(this, dependency_1.google.protobuf.Timestamp, 3) as dependency_1.google.protobuf.Timestamp;
+    }
+    set scheduled_start(value: dependency_1.google.protobuf.Timestamp) {
+        pb_1.Message.setWrapperField(this, 3, value);
+    }
+    get has_scheduled_start() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
     static fromObject(data: {
         lobby_name?: string;
+        is_public?: boolean;
+        scheduled_start?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
     }): CreateLobbyReq {
         const message = new CreateLobbyReq({
             lobby_name: data.lobby_name
         });
+        if (data.is_public != null) {
+            message.is_public = data.is_public;
+        }
+        if (data.scheduled_start != null) {
+            message.scheduled_start = dependency_1.google.protobuf.Timestamp.fromObject(data.scheduled_start);
+        }
         return message;
     }
     toObject() {
         const data: {
             lobby_name?: string;
+            is_public?: boolean;
+            scheduled_start?: ReturnType<typeof dependency_1.google.protobuf.Timestamp.prototype.toObject>;
         } = {};
         if (this.lobby_name != null) {
             data.lobby_name = this.lobby_name;
         }
+        if (this.is_public != null) {
+            data.is_public = this.is_public;
+        }
+        if (this.scheduled_start != null) {
+            data.scheduled_start = this.scheduled_start.toObject();
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -1267,6 +8225,10 @@ export class CreateLobbyReq extends pb_1.Message {
         const writer = w || new pb_1.BinaryWriter();
         if (this.has_lobby_name && this.lobby_name.length)
             writer.writeString(1, this.lobby_name);
+        if (this.has_is_public)
+            writer.writeBool(2, this.is_public);
+        if (this.has_scheduled_start)
+            writer.writeMessage(3, this.scheduled_start, () => this.scheduled_start.serialize(writer));
         if (!w)
             return writer.getResultBuffer();
     }
@@ -1279,6 +8241,12 @@ export class CreateLobbyReq extends pb_1.Message {
                 case 1:
                     message.lobby_name = reader.readString();
                     break;
+                case 2:
+                    message.is_public = reader.readBool();
+                    break;
+                case 3:
+                    reader.readMessage(message.scheduled_start, () => message.scheduled_start = dependency_1.google.protobuf.Timestamp.deserialize(reader));
+                    break;
                 default: reader.skipField();
             }
         }
@@ -1364,6 +8332,7 @@ export class LoginRequest extends pb_1.Message {
         username: string;
         password?: string;
         lobby_id: string;
+        spectator?: boolean;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
@@ -1373,6 +8342,9 @@ export class LoginRequest extends pb_1.Message {
                 this.password = data.password;
             }
             this.lobby_id = data.lobby_id;
+            if ("spectator" in data && data.spectator != undefined) {
+                this.spectator = data.spectator;
+            }
         }
     }
     get username() {
@@ -1402,10 +8374,20 @@ export class LoginRequest extends pb_1.Message {
     get has_lobby_id() {
         return pb_1.Message.getField(this, 3) != null;
     }
+    get spectator() {
+        return pb_1.Message.getFieldWithDefault(this, 4, false) as boolean;
+    }
+    set spectator(value: boolean) {
+        pb_1.Message.setField(this, 4, value);
+    }
+    get has_spectator() {
+        return pb_1.Message.getField(this, 4) != null;
+    }
     static fromObject(data: {
         username?: string;
         password?: string;
         lobby_id?: string;
+        spectator?: boolean;
     }): LoginRequest {
         const message = new LoginRequest({
             username: data.username,
@@ -1414,6 +8396,9 @@ export class LoginRequest extends pb_1.Message {
         if (data.password != null) {
             message.password = data.password;
         }
+        if (data.spectator != null) {
+            message.spectator = data.spectator;
+        }
         return message;
     }
     toObject() {
@@ -1421,6 +8406,7 @@ export class LoginRequest extends pb_1.Message {
             username?: string;
             password?: string;
             lobby_id?: string;
+            spectator?: boolean;
         } = {};
         if (this.username != null) {
             data.username = this.username;
@@ -1431,6 +8417,9 @@ export class LoginRequest extends pb_1.Message {
         if (this.lobby_id != null) {
             data.lobby_id = this.lobby_id;
         }
+        if (this.spectator != null) {
+            data.spectator = this.spectator;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -1443,6 +8432,8 @@ export class LoginRequest extends pb_1.Message {
             writer.writeString(2, this.password);
         if (this.has_lobby_id && this.lobby_id.length)
             writer.writeString(3, this.lobby_id);
+        if (this.has_spectator)
+            writer.writeBool(4, this.spectator);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -1461,6 +8452,9 @@ export class LoginRequest extends pb_1.Message {
                 case 3:
                     message.lobby_id = reader.readString();
                     break;
+                case 4:
+                    message.spectator = reader.readBool();
+                    break;
                 default: reader.skipField();
             }
         }
@@ -1478,12 +8472,16 @@ export class LoginResponse extends pb_1.Message {
     constructor(data?: any[] | {
         otp: string;
         is_owner: boolean;
+        is_spectator?: boolean;
     }) {
         super();
         pb_1.Message.initialize(this, Array.isArray(data) ? data : [], 0, -1, [], this.#one_of_decls);
         if (!Array.isArray(data) && typeof data == "object") {
             this.otp = data.otp;
             this.is_owner = data.is_owner;
+            if ("is_spectator" in data && data.is_spectator != undefined) {
+                this.is_spectator = data.is_spectator;
+            }
         }
     }
     get otp() {
@@ -1504,20 +8502,34 @@ export class LoginResponse extends pb_1.Message {
     get has_is_owner() {
         return pb_1.Message.getField(this, 2) != null;
     }
+    get is_spectator() {
+        return pb_1.Message.getFieldWithDefault(this, 3, false) as boolean;
+    }
+    set is_spectator(value: boolean) {
+        pb_1.Message.setField(this, 3, value);
+    }
+    get has_is_spectator() {
+        return pb_1.Message.getField(this, 3) != null;
+    }
     static fromObject(data: {
         otp?: string;
         is_owner?: boolean;
+        is_spectator?: boolean;
     }): LoginResponse {
         const message = new LoginResponse({
             otp: data.otp,
             is_owner: data.is_owner
         });
+        if (data.is_spectator != null) {
+            message.is_spectator = data.is_spectator;
+        }
         return message;
     }
     toObject() {
         const data: {
             otp?: string;
             is_owner?: boolean;
+            is_spectator?: boolean;
         } = {};
         if (this.otp != null) {
             data.otp = this.otp;
@@ -1525,6 +8537,9 @@ export class LoginResponse extends pb_1.Message {
         if (this.is_owner != null) {
             data.is_owner = this.is_owner;
         }
+        if (this.is_spectator != null) {
+            data.is_spectator = this.is_spectator;
+        }
         return data;
     }
     serialize(): Uint8Array;
@@ -1535,6 +8550,8 @@ export class LoginResponse extends pb_1.Message {
             writer.writeString(1, this.otp);
         if (this.has_is_owner)
             writer.writeBool(2, this.is_owner);
+        if (this.has_is_spectator)
+            writer.writeBool(3, this.is_spectator);
         if (!w)
             return writer.getResultBuffer();
     }
@@ -1550,6 +8567,9 @@ export class LoginResponse extends pb_1.Message {
                 case 2:
                     message.is_owner = reader.readBool();
                     break;
+                case 3:
+                    message.is_spectator = reader.readBool();
+                    break;
                 default: reader.skipField();
             }
         }